@@ -12,7 +12,11 @@ import (
 	"syscall"
 	"time"
 
+	"unified-ads-mcp/internal/config"
+	"unified-ads-mcp/internal/facebook"
+	"unified-ads-mcp/internal/facebook/generated/common"
 	"unified-ads-mcp/internal/facebook/tools"
+	"unified-ads-mcp/internal/shared"
 	"unified-ads-mcp/internal/utils"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -31,7 +35,7 @@ func NewProductionLogger(debugMode bool) *ProductionLogger {
 		flags |= log.Lshortfile
 	}
 	return &ProductionLogger{
-		logger: log.New(os.Stdout, "[FB-MCP] ", flags),
+		logger: log.New(shared.NewRedactingWriter(os.Stdout), "[FB-MCP] ", flags),
 	}
 }
 
@@ -91,6 +95,21 @@ func (rt *RequestTracker) getDuration(id any) time.Duration {
 	return 0
 }
 
+// redactedJSON encodes an MCP message for logging with credentials and
+// personal data removed
+func redactedJSON(message any) string {
+	msgJSON, err := json.Marshal(message)
+	if err != nil {
+		return ""
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(msgJSON, &decoded); err != nil {
+		return shared.Redact(string(msgJSON))
+	}
+	redacted, _ := json.Marshal(shared.RedactValue(decoded))
+	return string(redacted)
+}
+
 // CreateProductionHooks creates hooks with proper logging and monitoring
 func CreateProductionHooks(logger *ProductionLogger, tracker *RequestTracker, debugMode bool) *server.Hooks {
 	hooks := &server.Hooks{}
@@ -129,15 +148,14 @@ func CreateProductionHooks(logger *ProductionLogger, tracker *RequestTracker, de
 					}
 				} else {
 					logger.Info("Tool call: %s (request_id=%v, args=%v)",
-						callReq.Params.Name, id, callReq.Params.Arguments)
+						callReq.Params.Name, id, shared.RedactValue(callReq.Params.Arguments))
 				}
 			} else if debugMode {
-				logger.Debug("Tool call raw (request_id=%v): %s", id, string(msgJSON))
+				logger.Debug("Tool call raw (request_id=%v): %s", id, redactedJSON(message))
 			}
 		default:
 			if debugMode {
-				msgJSON, _ := json.Marshal(message)
-				logger.Debug("Method %s called (request_id=%v): %s", method, id, string(msgJSON))
+				logger.Debug("Method %s called (request_id=%v): %s", method, id, redactedJSON(message))
 			}
 		}
 	})
@@ -170,9 +188,8 @@ func CreateProductionHooks(logger *ProductionLogger, tracker *RequestTracker, de
 	// Log errors with details
 	hooks.AddOnError(func(ctx context.Context, id any, method mcp.MCPMethod, message any, err error) {
 		duration := tracker.getDuration(id)
-		msgJSON, _ := json.Marshal(message)
 		logger.Error("Method %s failed after %v (request_id=%v): %v | Request: %s",
-			method, duration, id, err, string(msgJSON))
+			method, duration, id, err, redactedJSON(message))
 		tracker.startTimes.Delete(id)
 	})
 
@@ -197,6 +214,14 @@ func NewFacebookMCPServer(logger *ProductionLogger, debugMode bool) *server.MCPS
 		server.WithLogging(),
 		server.WithHooks(hooks),
 		server.WithRecovery(), // Enable panic recovery
+		server.WithElicitation(),
+		server.WithToolHandlerMiddleware(tools.APIVersionMiddleware),
+		server.WithToolHandlerMiddleware(tools.RateLimitUsageMiddleware),
+		server.WithToolHandlerMiddleware(tools.RetryReportMiddleware),
+		server.WithToolHandlerMiddleware(tools.AuditContextMiddleware),
+		server.WithToolHandlerMiddleware(tools.IdempotencyMiddleware),
+		server.WithToolHandlerMiddleware(tools.ConfirmationMiddleware),
+		server.WithToolHandlerMiddleware(tools.SnapshotMiddleware),
 	)
 
 	// Register tools with logging
@@ -209,32 +234,44 @@ func NewFacebookMCPServer(logger *ProductionLogger, debugMode bool) *server.MCPS
 		logger.Info("✓ Tool manager registered")
 	}
 
-	if err := tools.RegisterAccountTools(mcpServer); err != nil {
+	if err := tools.RegisterAuditLogTool(mcpServer); err != nil {
+		logger.Error("Failed to register audit log tool: %v", err)
+	} else {
+		logger.Info("✓ Audit log tool registered")
+	}
+
+	if err := tools.RegisterFiltered(mcpServer, tools.RegisterUndoChangeTool); err != nil {
+		logger.Error("Failed to register undo tool: %v", err)
+	} else {
+		logger.Info("✓ Undo tool registered")
+	}
+
+	if err := tools.RegisterFiltered(mcpServer, tools.RegisterAccountTools); err != nil {
 		logger.Error("Failed to register account tools: %v", err)
 	} else {
 		logger.Info("✓ Account tools registered")
 	}
 
-	if err := tools.RegisterBatchTools(mcpServer); err != nil {
+	if err := tools.RegisterFiltered(mcpServer, tools.RegisterBatchTools); err != nil {
 		logger.Error("Failed to register batch tools: %v", err)
 	} else {
 		logger.Info("✓ Batch tools registered")
 	}
 
 	// Register video tools
-	if err := tools.RegisterVideoUploadTool(mcpServer); err != nil {
+	if err := tools.RegisterFiltered(mcpServer, tools.RegisterVideoUploadTool); err != nil {
 		logger.Error("Failed to register video upload tool: %v", err)
 	} else {
 		logger.Info("✓ Video upload tool registered")
 	}
 
-	if err := tools.RegisterVideoStatusTool(mcpServer); err != nil {
+	if err := tools.RegisterFiltered(mcpServer, tools.RegisterVideoStatusTool); err != nil {
 		logger.Error("Failed to register video status tool: %v", err)
 	} else {
 		logger.Info("✓ Video status tool registered")
 	}
 
-	if err := tools.RegisterVideoUploadBatchTool(mcpServer); err != nil {
+	if err := tools.RegisterFiltered(mcpServer, tools.RegisterVideoUploadBatchTool); err != nil {
 		logger.Error("Failed to register video batch upload tool: %v", err)
 	} else {
 		logger.Info("✓ Video batch upload tool registered")
@@ -244,6 +281,11 @@ func NewFacebookMCPServer(logger *ProductionLogger, debugMode bool) *server.MCPS
 	healthCheckTool := mcp.NewTool(
 		"health_check",
 		mcp.WithDescription("Check server health status"),
+		mcp.WithTitleAnnotation("Health Check"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(false),
 	)
 	mcpServer.AddTool(healthCheckTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		status := map[string]interface{}{
@@ -254,6 +296,9 @@ func NewFacebookMCPServer(logger *ProductionLogger, debugMode bool) *server.MCPS
 			// Note: Tool count would require tracking during registration
 			"uptime": time.Since(serverStartTime).String(),
 		}
+		if limiter := facebook.DefaultClient().RateLimiter(); limiter != nil {
+			status["facebook_rate_limit"] = limiter.Usage()
+		}
 		statusJSON, _ := json.Marshal(status)
 		return mcp.NewToolResultText(string(statusJSON)), nil
 	})
@@ -321,14 +366,30 @@ func startWithGracefulShutdown(mcpServer *server.MCPServer, transport string, lo
 
 func main() {
 	var (
-		transport string
-		debugMode bool
-		version   bool
+		transport  string
+		debugMode  bool
+		readOnly   bool
+		dryRun     bool
+		auditLog   string
+		snapshots  string
+		policy     string
+		budgetUp   float64
+		massStatus int
+		idemWindow time.Duration
+		version    bool
 	)
 
 	flag.StringVar(&transport, "t", "stdio", "Transport type (stdio or http)")
 	flag.StringVar(&transport, "transport", "stdio", "Transport type (stdio or http)")
 	flag.BoolVar(&debugMode, "debug", false, "Enable debug logging")
+	flag.BoolVar(&readOnly, "read-only", false, "Register only GET-backed tools and reject non-GET batch operations (or set FACEBOOK_READ_ONLY=true)")
+	flag.BoolVar(&dryRun, "dry-run", false, "Validate or preview every change instead of applying it (or set FACEBOOK_DRY_RUN=true)")
+	flag.StringVar(&auditLog, "audit-log", "", "Path of the JSONL audit log of changes, or \"off\" (or set FACEBOOK_AUDIT_LOG; default ~/.unified-ads-mcp/audit.jsonl)")
+	flag.StringVar(&snapshots, "snapshot-dir", "", "Directory where objects are saved before updates and deletes so undo_change can restore them, or \"off\" (or set FACEBOOK_SNAPSHOT_DIR; default ~/.unified-ads-mcp/snapshots)")
+	flag.StringVar(&policy, "policy", "", "Path of a JSON policy every change is checked against (or set FACEBOOK_POLICY_FILE)")
+	flag.Float64Var(&budgetUp, "confirm-budget-increase", -1, "Ask for confirmation before budget increases larger than this percentage, 0 to disable (or set FACEBOOK_CONFIRM_BUDGET_INCREASE_PERCENT; default 50)")
+	flag.IntVar(&massStatus, "confirm-mass-status", -1, "Ask for confirmation before batch_update_campaigns changes the status of this many campaigns, 0 to disable (or set FACEBOOK_CONFIRM_MASS_STATUS; default 5)")
+	flag.DurationVar(&idemWindow, "idempotency-window", -1, "How long create results are kept so a retry with the same idempotency_key returns the original result, 0 to disable (or set FACEBOOK_IDEMPOTENCY_WINDOW; default 24h)")
 	flag.BoolVar(&version, "version", false, "Show version information")
 	flag.Parse()
 
@@ -337,7 +398,9 @@ func main() {
 		os.Exit(0)
 	}
 
-	// Initialize production logger
+	// Initialize production logger. Package-level log output from the Graph
+	// API client is redacted the same way.
+	log.SetOutput(shared.NewRedactingWriter(os.Stderr))
 	logger := NewProductionLogger(debugMode)
 
 	// Print startup banner
@@ -352,6 +415,135 @@ func main() {
 	// Load configuration
 	logger.Info("Loading Facebook configuration...")
 	utils.LoadFacebookConfig()
+	cfg, err := config.Load()
+	if err != nil {
+		logger.Error("Failed to load configuration: %v", err)
+		os.Exit(1)
+	}
+
+	// Record every non-GET Graph API request in the audit log
+	var clientOptions []facebook.Option
+	if auditLog == "" {
+		auditLog = cfg.Facebook.AuditLog
+	}
+	if auditLog == "" {
+		auditLog = facebook.DefaultAuditLogPath()
+	}
+	if auditLog != "off" {
+		audit, err := facebook.OpenAuditLog(auditLog)
+		if err != nil {
+			logger.Error("Failed to open audit log: %v", err)
+			os.Exit(1)
+		}
+		defer audit.Close()
+		facebook.SetDefaultAuditLog(audit)
+		clientOptions = append(clientOptions, facebook.WithMiddleware(audit.Middleware()))
+		logger.Info("Audit log: %s", audit.Path())
+	} else {
+		logger.Warn("Audit logging is disabled")
+	}
+
+	// Read-only mode can be enabled by flag or configuration. Besides
+	// registering only read tools, the client rejects every request that is
+	// not a GET.
+	readOnly = readOnly || cfg.Facebook.ReadOnly
+	if readOnly {
+		clientOptions = append(clientOptions, facebook.WithRequestCheck(facebook.ReadOnlyRequestCheck()))
+	}
+
+	// Check every change against the policy before it is sent. Rejected
+	// requests are still recorded in the audit log.
+	if policy == "" {
+		policy = cfg.Facebook.PolicyFile
+	}
+	if policy != "" {
+		rules, err := facebook.LoadPolicy(policy)
+		if err != nil {
+			logger.Error("Failed to load policy: %v", err)
+			os.Exit(1)
+		}
+		clientOptions = append(clientOptions, facebook.WithRequestCheck(facebook.NewPolicyEngine(rules).RequestCheck()))
+		logger.Info("Policy: %s (rules: %v)", policy, rules.Rules())
+	}
+
+	// Save objects before updates and deletes so undo_change can restore them
+	if snapshots == "" {
+		snapshots = cfg.Facebook.SnapshotDir
+	}
+	if snapshots == "" {
+		snapshots = facebook.DefaultSnapshotDir()
+	}
+	if snapshots != "off" {
+		store, err := facebook.OpenSnapshotStore(snapshots)
+		if err != nil {
+			logger.Error("Failed to open snapshot directory: %v", err)
+			os.Exit(1)
+		}
+		facebook.SetDefaultSnapshotStore(store)
+		logger.Info("Change snapshots: %s", store.Dir())
+	} else {
+		logger.Warn("Change snapshots are disabled; undo_change will not be available")
+	}
+
+	// Keep create results so retries with the same idempotency_key replay
+	// them instead of creating duplicates
+	window := facebook.DefaultIdempotencyWindow
+	if cfg.Facebook.IdempotencyWindow != nil {
+		window = *cfg.Facebook.IdempotencyWindow
+	}
+	if idemWindow >= 0 {
+		window = idemWindow
+	}
+	if window > 0 {
+		store, err := facebook.OpenIdempotencyStore(facebook.DefaultIdempotencyDir(), window)
+		if err != nil {
+			logger.Error("Failed to open idempotency directory: %v", err)
+			os.Exit(1)
+		}
+		facebook.SetDefaultIdempotencyStore(store)
+		logger.Info("Idempotency keys: %s (window %s)", store.Dir(), store.Window())
+	} else {
+		logger.Warn("Idempotency keys are disabled; retried creates may create duplicates")
+	}
+
+	// Share a single Graph API client across all Facebook tools
+	client := facebook.NewClient(cfg.Facebook, clientOptions...)
+	if err := facebook.ValidateAPIVersion(client.APIVersion()); err != nil {
+		logger.Error("Invalid FACEBOOK_API_VERSION: %v", err)
+		os.Exit(1)
+	}
+	facebook.SetDefaultClient(client)
+	logger.Info("Graph API version: %s", client.APIVersion())
+	if warning := facebook.GeneratedVersionWarning(client.APIVersion()); warning != "" {
+		logger.Warn("%s", warning)
+	}
+
+	tools.SetReadOnly(readOnly)
+	logger.Info("Read-only mode: %v", tools.ReadOnly())
+
+	// Dry-run mode can be enabled by flag or configuration; calls can also
+	// ask for a dry run with dry_run=true
+	common.SetDryRun(dryRun || cfg.Facebook.DryRun)
+	logger.Info("Dry-run mode: %v", common.DryRunEnabled())
+
+	// Deletes always need confirmation; budget increases and mass status
+	// changes above these thresholds do too
+	confirmSettings := tools.DefaultConfirmationSettings
+	if cfg.Facebook.ConfirmBudgetIncreasePercent != nil {
+		confirmSettings.BudgetIncreasePercent = *cfg.Facebook.ConfirmBudgetIncreasePercent
+	}
+	if cfg.Facebook.ConfirmMassStatus != nil {
+		confirmSettings.MassStatusChanges = *cfg.Facebook.ConfirmMassStatus
+	}
+	if budgetUp >= 0 {
+		confirmSettings.BudgetIncreasePercent = budgetUp
+	}
+	if massStatus >= 0 {
+		confirmSettings.MassStatusChanges = massStatus
+	}
+	tools.SetConfirmationSettings(confirmSettings)
+	logger.Info("Confirmation thresholds: budget increase %g%%, mass status change %d campaigns",
+		confirmSettings.BudgetIncreasePercent, confirmSettings.MassStatusChanges)
 
 	// Create and start the server
 	mcpServer := NewFacebookMCPServer(logger, debugMode)
@@ -1,7 +1,12 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Config struct {
@@ -21,6 +26,28 @@ type FacebookConfig struct {
 	AppID       string
 	AppSecret   string
 	AccessToken string
+	APIVersion  string
+	// ReadOnly restricts the server to GET requests
+	ReadOnly bool
+	// DryRun validates or previews every change instead of applying it
+	DryRun bool
+	// AuditLog is the path of the audit log of changes; "off" disables it
+	AuditLog string
+	// SnapshotDir is where objects are saved before updates and deletes; "off"
+	// disables snapshots
+	SnapshotDir string
+	// PolicyFile is the path of a JSON policy every change is checked against
+	PolicyFile string
+	// ConfirmBudgetIncreasePercent overrides the budget increase, in percent,
+	// that needs confirmation; nil keeps the default
+	ConfirmBudgetIncreasePercent *float64
+	// ConfirmMassStatus overrides how many campaign status changes in one
+	// batch need confirmation; nil keeps the default
+	ConfirmMassStatus *int
+	// IdempotencyWindow overrides how long create results are kept for
+	// replay by idempotency key; 0 disables idempotency keys and nil keeps
+	// the default
+	IdempotencyWindow *time.Duration
 }
 
 type TikTokConfig struct {
@@ -29,8 +56,12 @@ type TikTokConfig struct {
 	AccessToken string
 }
 
+// Load reads the configuration from the environment. Set variables that
+// cannot be parsed are an error rather than being ignored, so a typo never
+// silently turns off a setting such as FACEBOOK_READ_ONLY.
 func Load() (*Config, error) {
-	return &Config{
+	env := &envReader{}
+	cfg := &Config{
 		Google: &GoogleConfig{
 			ClientID:       os.Getenv("GOOGLE_CLIENT_ID"),
 			ClientSecret:   os.Getenv("GOOGLE_CLIENT_SECRET"),
@@ -41,11 +72,98 @@ func Load() (*Config, error) {
 			AppID:       os.Getenv("FACEBOOK_APP_ID"),
 			AppSecret:   os.Getenv("FACEBOOK_APP_SECRET"),
 			AccessToken: os.Getenv("FACEBOOK_ACCESS_TOKEN"),
+			APIVersion:  os.Getenv("FACEBOOK_API_VERSION"),
+			ReadOnly:    env.bool("FACEBOOK_READ_ONLY"),
+			DryRun:      env.bool("FACEBOOK_DRY_RUN"),
+			AuditLog:    os.Getenv("FACEBOOK_AUDIT_LOG"),
+			SnapshotDir: os.Getenv("FACEBOOK_SNAPSHOT_DIR"),
+			PolicyFile:  os.Getenv("FACEBOOK_POLICY_FILE"),
+
+			ConfirmBudgetIncreasePercent: env.float("FACEBOOK_CONFIRM_BUDGET_INCREASE_PERCENT"),
+			ConfirmMassStatus:            env.int("FACEBOOK_CONFIRM_MASS_STATUS"),
+			IdempotencyWindow:            env.duration("FACEBOOK_IDEMPOTENCY_WINDOW"),
 		},
 		TikTok: &TikTokConfig{
 			AppID:       os.Getenv("TIKTOK_APP_ID"),
 			Secret:      os.Getenv("TIKTOK_SECRET"),
 			AccessToken: os.Getenv("TIKTOK_ACCESS_TOKEN"),
 		},
-	}, nil
+	}
+	if err := errors.Join(env.errs...); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// envReader parses typed environment variables and collects the errors of
+// values that cannot be parsed
+type envReader struct {
+	errs []error
+}
+
+// lookup returns the trimmed value of a variable and whether it is set
+func (r *envReader) lookup(key string) (string, bool) {
+	value := strings.TrimSpace(os.Getenv(key))
+	return value, value != ""
+}
+
+func (r *envReader) invalid(key, value, want string) {
+	r.errs = append(r.errs, fmt.Errorf("invalid %s %q: expected %s", key, value, want))
+}
+
+// bool reads a boolean environment variable; unset values are false
+func (r *envReader) bool(key string) bool {
+	value, ok := r.lookup(key)
+	if !ok {
+		return false
+	}
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		r.invalid(key, value, "true or false")
+		return false
+	}
+	return enabled
+}
+
+// float reads a numeric environment variable; unset values are nil
+func (r *envReader) float(key string) *float64 {
+	value, ok := r.lookup(key)
+	if !ok {
+		return nil
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		r.invalid(key, value, "a number")
+		return nil
+	}
+	return &parsed
+}
+
+// int reads an integer environment variable; unset values are nil
+func (r *envReader) int(key string) *int {
+	value, ok := r.lookup(key)
+	if !ok {
+		return nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		r.invalid(key, value, "an integer")
+		return nil
+	}
+	return &parsed
+}
+
+// duration reads a duration environment variable such as "24h"; unset values
+// are nil
+func (r *envReader) duration(key string) *time.Duration {
+	value, ok := r.lookup(key)
+	if !ok {
+		return nil
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		r.invalid(key, value, `a duration such as "24h"`)
+		return nil
+	}
+	return &parsed
 }
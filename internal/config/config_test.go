@@ -0,0 +1,57 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoad_ParsesTypedValues(t *testing.T) {
+	t.Setenv("FACEBOOK_READ_ONLY", "true")
+	t.Setenv("FACEBOOK_DRY_RUN", "")
+	t.Setenv("FACEBOOK_CONFIRM_BUDGET_INCREASE_PERCENT", "25")
+	t.Setenv("FACEBOOK_CONFIRM_MASS_STATUS", "")
+	t.Setenv("FACEBOOK_IDEMPOTENCY_WINDOW", "2h")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	fb := cfg.Facebook
+	if !fb.ReadOnly || fb.DryRun {
+		t.Errorf("Expected read-only without dry run, got ReadOnly=%v DryRun=%v", fb.ReadOnly, fb.DryRun)
+	}
+	if fb.ConfirmBudgetIncreasePercent == nil || *fb.ConfirmBudgetIncreasePercent != 25 {
+		t.Errorf("Expected a 25%% budget threshold, got %v", fb.ConfirmBudgetIncreasePercent)
+	}
+	if fb.ConfirmMassStatus != nil {
+		t.Errorf("Expected an unset value to be nil, got %v", *fb.ConfirmMassStatus)
+	}
+	if fb.IdempotencyWindow == nil || *fb.IdempotencyWindow != 2*time.Hour {
+		t.Errorf("Expected a 2h idempotency window, got %v", fb.IdempotencyWindow)
+	}
+}
+
+func TestLoad_RejectsInvalidValues(t *testing.T) {
+	t.Setenv("FACEBOOK_READ_ONLY", "yes")
+	t.Setenv("FACEBOOK_DRY_RUN", "on")
+	t.Setenv("FACEBOOK_CONFIRM_BUDGET_INCREASE_PERCENT", "50%")
+	t.Setenv("FACEBOOK_CONFIRM_MASS_STATUS", "five")
+	t.Setenv("FACEBOOK_IDEMPOTENCY_WINDOW", "1 day")
+
+	_, err := Load()
+	if err == nil {
+		t.Fatal("Expected invalid values to be rejected")
+	}
+	for _, key := range []string{
+		"FACEBOOK_READ_ONLY",
+		"FACEBOOK_DRY_RUN",
+		"FACEBOOK_CONFIRM_BUDGET_INCREASE_PERCENT",
+		"FACEBOOK_CONFIRM_MASS_STATUS",
+		"FACEBOOK_IDEMPOTENCY_WINDOW",
+	} {
+		if !strings.Contains(err.Error(), key) {
+			t.Errorf("Expected the error to name %s, got %v", key, err)
+		}
+	}
+}
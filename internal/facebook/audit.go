@@ -0,0 +1,398 @@
+package facebook
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"unified-ads-mcp/internal/shared"
+)
+
+// Audit outcomes
+const (
+	AuditOutcomeSuccess = "success"
+	AuditOutcomeError   = "error"
+)
+
+// maxAuditResponseBytes caps how much of a response body is kept per entry
+const maxAuditResponseBytes = 64 << 10
+
+// AuditEntry records one Graph API request that could change something
+type AuditEntry struct {
+	Time      time.Time `json:"time"`
+	SessionID string    `json:"session_id,omitempty"`
+	Tool      string    `json:"tool,omitempty"`
+	Method    string    `json:"method"`
+	Path      string    `json:"path"`
+	// ObjectIDs are the Graph objects the request targets. A batch lists the
+	// object of each operation.
+	ObjectIDs  []string    `json:"object_ids,omitempty"`
+	Request    interface{} `json:"request,omitempty"`
+	StatusCode int         `json:"status_code,omitempty"`
+	Response   interface{} `json:"response,omitempty"`
+	Outcome    string      `json:"outcome"`
+	Error      string      `json:"error,omitempty"`
+	DurationMS int64       `json:"duration_ms"`
+}
+
+// AuditFilter selects audit entries. Zero fields match everything.
+type AuditFilter struct {
+	ObjectID string
+	Tool     string
+	Since    time.Time
+	Until    time.Time
+	// Limit caps the number of entries returned, newest first
+	Limit int
+}
+
+// Matches reports whether entry satisfies the filter
+func (f AuditFilter) Matches(entry AuditEntry) bool {
+	if f.Tool != "" && entry.Tool != f.Tool {
+		return false
+	}
+	if !f.Since.IsZero() && entry.Time.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && entry.Time.After(f.Until) {
+		return false
+	}
+	if f.ObjectID == "" {
+		return true
+	}
+	for _, id := range entry.ObjectIDs {
+		if id == f.ObjectID || strings.TrimPrefix(id, "act_") == strings.TrimPrefix(f.ObjectID, "act_") {
+			return true
+		}
+	}
+	return false
+}
+
+// AuditLog is an append-only JSONL file of every non-GET Graph API request
+type AuditLog struct {
+	path string
+	mu   sync.Mutex
+	file *os.File
+	now  func() time.Time
+}
+
+// OpenAuditLog opens or creates the audit log at path. Entries are only ever
+// appended.
+func OpenAuditLog(path string) (*AuditLog, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create audit log directory: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	return &AuditLog{path: path, file: file, now: time.Now}, nil
+}
+
+// Path returns the file the log is written to
+func (l *AuditLog) Path() string {
+	return l.path
+}
+
+// Close closes the log file
+func (l *AuditLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.file.Close()
+}
+
+// Record appends an entry and syncs it to disk
+func (l *AuditLog) Record(entry AuditEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err := l.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit entry: %w", err)
+	}
+	return l.file.Sync()
+}
+
+// Query returns the entries matching filter, newest first
+func (l *AuditLog) Query(filter AuditFilter) ([]AuditEntry, error) {
+	file, err := os.Open(l.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer file.Close()
+
+	var matches []AuditEntry
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64<<10), 4*maxAuditResponseBytes)
+	for scanner.Scan() {
+		var entry AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			// A torn final line from a crash is skipped rather than failing the query
+			continue
+		}
+		if filter.Matches(entry) {
+			matches = append(matches, entry)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+
+	for i, j := 0, len(matches)-1; i < j; i, j = i+1, j-1 {
+		matches[i], matches[j] = matches[j], matches[i]
+	}
+	if filter.Limit > 0 && len(matches) > filter.Limit {
+		matches = matches[:filter.Limit]
+	}
+	return matches, nil
+}
+
+// Middleware records every non-GET request passing through the client. The
+// tool and MCP session are taken from the request context.
+func (l *AuditLog) Middleware() Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			if req.Method == http.MethodGet {
+				return next(req)
+			}
+
+			call, _ := ToolCallFromContext(req.Context())
+			entry := AuditEntry{
+				Time:      l.now().UTC(),
+				SessionID: call.SessionID,
+				Tool:      call.Tool,
+				Method:    req.Method,
+				Path:      shared.Redact(req.URL.Path),
+			}
+			payload := requestParams(req)
+			entry.ObjectIDs = auditObjectIDs(req.URL.Path, payload)
+			if len(payload) > 0 {
+				entry.Request = shared.RedactValue(payload)
+			}
+
+			start := time.Now()
+			resp, err := next(req)
+			entry.DurationMS = time.Since(start).Milliseconds()
+
+			switch {
+			case err != nil:
+				entry.Outcome = AuditOutcomeError
+				entry.Error = shared.Redact(err.Error())
+			default:
+				entry.StatusCode = resp.StatusCode
+				entry.Outcome = AuditOutcomeSuccess
+				if resp.StatusCode >= 400 {
+					entry.Outcome = AuditOutcomeError
+				}
+				entry.Response = auditResponseBody(resp)
+			}
+
+			if recordErr := l.Record(entry); recordErr != nil {
+				log.Printf("[ERROR] %v", recordErr)
+			}
+			return resp, err
+		}
+	}
+}
+
+// requestParams returns the parameters of a request: its query string
+// and form, JSON or multipart body, without credentials. Form values holding
+// JSON are decoded. File parts are replaced by their name and size.
+func requestParams(req *http.Request) map[string]interface{} {
+	payload := make(map[string]interface{})
+	for key := range req.URL.Query() {
+		payload[key] = decodeAuditValue(req.URL.Query().Get(key))
+	}
+
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			defer body.Close()
+			readAuditBody(req.Header.Get("Content-Type"), body, payload)
+		}
+	}
+
+	delete(payload, "access_token")
+	delete(payload, "appsecret_proof")
+	return payload
+}
+
+func readAuditBody(contentType string, body io.Reader, payload map[string]interface{}) {
+	mediaType, params, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "application/x-www-form-urlencoded":
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return
+		}
+		form, err := url.ParseQuery(string(data))
+		if err != nil {
+			return
+		}
+		for key := range form {
+			payload[key] = decodeAuditValue(form.Get(key))
+		}
+
+	case "application/json":
+		var decoded map[string]interface{}
+		if err := json.NewDecoder(body).Decode(&decoded); err == nil {
+			for key, value := range decoded {
+				payload[key] = value
+			}
+		}
+
+	case "multipart/form-data":
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := reader.NextPart()
+			if err != nil {
+				return
+			}
+			if part.FileName() != "" {
+				size, _ := io.Copy(io.Discard, part)
+				payload[part.FormName()] = fmt.Sprintf("<file %s, %d bytes>", part.FileName(), size)
+				continue
+			}
+			value, err := io.ReadAll(part)
+			if err != nil {
+				return
+			}
+			payload[part.FormName()] = decodeAuditValue(string(value))
+		}
+	}
+}
+
+// decodeAuditValue decodes form values the Graph API takes as JSON, such as
+// targeting and the batch parameter
+func decodeAuditValue(value string) interface{} {
+	trimmed := strings.TrimSpace(value)
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(trimmed), &decoded); err == nil {
+			return decoded
+		}
+	}
+	return value
+}
+
+// auditResponseBody reads the response body for the audit entry and restores
+// it for the caller
+func auditResponseBody(resp *http.Response) interface{} {
+	if resp.Body == nil {
+		return nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil || len(body) == 0 {
+		return nil
+	}
+	if len(body) > maxAuditResponseBytes {
+		return shared.Redact(string(body[:maxAuditResponseBytes])) + "...(truncated)"
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return shared.Redact(string(body))
+	}
+	return shared.RedactValue(decoded)
+}
+
+// auditObjectIDs returns the object a request path targets, or for a batch the
+// object of each operation
+func auditObjectIDs(path string, payload map[string]interface{}) []string {
+	if id := objectIDFromPath(path); id != "" {
+		return []string{id}
+	}
+	operations, ok := payload["batch"].([]interface{})
+	if !ok {
+		return nil
+	}
+	var ids []string
+	seen := make(map[string]bool)
+	for _, op := range operations {
+		fields, _ := op.(map[string]interface{})
+		relativeURL, _ := fields["relative_url"].(string)
+		if id := objectIDFromPath(strings.SplitN(relativeURL, "?", 2)[0]); id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// objectIDFromPath returns the first path segment after the API version, e.g.
+// "act_123" for /v23.0/act_123/campaigns
+func objectIDFromPath(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) > 0 && versionPattern.MatchString(segments[0]) {
+		segments = segments[1:]
+	}
+	if len(segments) == 0 {
+		return ""
+	}
+	return segments[0]
+}
+
+// ToolCall identifies the MCP tool call a Graph API request is made for
+type ToolCall struct {
+	Tool      string
+	SessionID string
+}
+
+type toolCallKey struct{}
+
+// ContextWithToolCall returns a context recording which tool call its Graph
+// API requests belong to
+func ContextWithToolCall(ctx context.Context, call ToolCall) context.Context {
+	return context.WithValue(ctx, toolCallKey{}, call)
+}
+
+// ToolCallFromContext returns the tool call recorded by ContextWithToolCall
+func ToolCallFromContext(ctx context.Context) (ToolCall, bool) {
+	call, ok := ctx.Value(toolCallKey{}).(ToolCall)
+	return call, ok
+}
+
+// DefaultAuditLogPath is where the audit log is kept unless configured
+// otherwise: ~/.unified-ads-mcp/audit.jsonl
+func DefaultAuditLogPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "audit.jsonl"
+	}
+	return filepath.Join(home, ".unified-ads-mcp", "audit.jsonl")
+}
+
+// Default audit log, set at startup when auditing is enabled
+var (
+	auditMu      sync.RWMutex
+	defaultAudit *AuditLog
+)
+
+// DefaultAuditLog returns the process-wide audit log, or nil when auditing is
+// disabled
+func DefaultAuditLog() *AuditLog {
+	auditMu.RLock()
+	defer auditMu.RUnlock()
+	return defaultAudit
+}
+
+// SetDefaultAuditLog replaces the process-wide audit log
+func SetDefaultAuditLog(l *AuditLog) {
+	auditMu.Lock()
+	defer auditMu.Unlock()
+	defaultAudit = l
+}
@@ -0,0 +1,115 @@
+package facebook
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"unified-ads-mcp/internal/facebook/testutil"
+)
+
+func TestAuditLog_RecordsMutations(t *testing.T) {
+	env := testutil.NewTestEnvironment(t)
+	defer env.Teardown()
+
+	env.Server().AddRoute("POST", "/"+DefaultAPIVersion+"/act_123/campaigns", func(w http.ResponseWriter, r *http.Request) {
+		env.Server().WriteSuccess(w, map[string]interface{}{"id": testutil.TestCampaignID})
+	})
+	env.Server().AddRoute("GET", "/"+DefaultAPIVersion+"/"+testutil.TestCampaignID, func(w http.ResponseWriter, r *http.Request) {
+		env.Server().WriteSuccess(w, map[string]interface{}{"id": testutil.TestCampaignID})
+	})
+	env.Server().AddRoute("POST", "/"+DefaultAPIVersion+"/", func(w http.ResponseWriter, r *http.Request) {
+		env.Server().WriteSuccess(w, []interface{}{
+			map[string]interface{}{"code": 200, "body": `{"success":true}`},
+			map[string]interface{}{"code": 200, "body": `{"success":true}`},
+		})
+	})
+
+	auditLog, err := OpenAuditLog(filepath.Join(t.TempDir(), "audit", "audit.jsonl"))
+	if err != nil {
+		t.Fatalf("OpenAuditLog failed: %v", err)
+	}
+	defer auditLog.Close()
+
+	client := NewClient(nil, WithGraphURL(env.Server().URL), WithAccessToken("test_token"), WithMiddleware(auditLog.Middleware()))
+	ctx := ContextWithToolCall(context.Background(), ToolCall{Tool: "ad_account_create_campaign", SessionID: "session-1"})
+
+	if _, err := client.SendParams(ctx, "POST", "act_123/campaigns", map[string]interface{}{
+		"name":                  "Spring Sale",
+		"special_ad_categories": []string{"NONE"},
+	}); err != nil {
+		t.Fatalf("SendParams failed: %v", err)
+	}
+	if _, err := client.Get(ctx, testutil.TestCampaignID, nil); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	batchCtx := ContextWithToolCall(context.Background(), ToolCall{Tool: "facebook_batch"})
+	if _, err := client.Batch(batchCtx, []BatchRequest{
+		{Method: "POST", RelativeURL: testutil.TestCampaignID, Body: map[string]interface{}{"status": "PAUSED"}},
+		{Method: "DELETE", RelativeURL: testutil.TestAdID + "?fields=id"},
+	}); err != nil {
+		t.Fatalf("Batch failed: %v", err)
+	}
+
+	entries, err := auditLog.Query(AuditFilter{})
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("Expected the GET to be skipped and 2 entries recorded, got %d", len(entries))
+	}
+
+	batch, create := entries[0], entries[1]
+	if create.Tool != "ad_account_create_campaign" || create.SessionID != "session-1" || create.Method != "POST" {
+		t.Errorf("Unexpected create entry: %+v", create)
+	}
+	if len(create.ObjectIDs) != 1 || create.ObjectIDs[0] != "act_123" || create.Outcome != AuditOutcomeSuccess || create.StatusCode != 200 {
+		t.Errorf("Unexpected create entry: %+v", create)
+	}
+	request, _ := create.Request.(map[string]interface{})
+	if request["name"] != "Spring Sale" {
+		t.Errorf("Expected the request payload to be recorded, got %v", create.Request)
+	}
+	if categories, ok := request["special_ad_categories"].([]interface{}); !ok || len(categories) != 1 {
+		t.Errorf("Expected JSON form values to be decoded, got %v", request["special_ad_categories"])
+	}
+	if _, ok := request["access_token"]; ok {
+		t.Error("Expected the access token to be left out of the audit log")
+	}
+	if response, _ := create.Response.(map[string]interface{}); response["id"] != testutil.TestCampaignID {
+		t.Errorf("Expected the response to be recorded, got %v", create.Response)
+	}
+
+	if batch.Tool != "facebook_batch" || len(batch.ObjectIDs) != 2 || batch.ObjectIDs[0] != testutil.TestCampaignID || batch.ObjectIDs[1] != testutil.TestAdID {
+		t.Errorf("Unexpected batch entry: %+v", batch)
+	}
+}
+
+func TestAuditFilter_Matches(t *testing.T) {
+	at := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	entry := AuditEntry{Time: at, Tool: "campaign_update", ObjectIDs: []string{"act_123", testutil.TestCampaignID}}
+
+	tests := []struct {
+		name   string
+		filter AuditFilter
+		want   bool
+	}{
+		{name: "empty", filter: AuditFilter{}, want: true},
+		{name: "object", filter: AuditFilter{ObjectID: testutil.TestCampaignID}, want: true},
+		{name: "account without prefix", filter: AuditFilter{ObjectID: "123"}, want: true},
+		{name: "other object", filter: AuditFilter{ObjectID: testutil.TestAdID}, want: false},
+		{name: "tool", filter: AuditFilter{Tool: "campaign_delete"}, want: false},
+		{name: "inside window", filter: AuditFilter{Since: at.Add(-time.Hour), Until: at.Add(time.Hour)}, want: true},
+		{name: "before window", filter: AuditFilter{Since: at.Add(time.Minute)}, want: false},
+		{name: "after window", filter: AuditFilter{Until: at.Add(-time.Minute)}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches(entry); got != tt.want {
+				t.Errorf("Matches = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package facebook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// MaxBatchSize is the maximum number of requests the Graph API accepts in one batch
+const MaxBatchSize = 50
+
+// BatchRequest represents a single request in a batch
+type BatchRequest struct {
+	Method      string                 `json:"method"`
+	RelativeURL string                 `json:"relative_url"`
+	Body        map[string]interface{} `json:"body,omitempty"`
+	Headers     map[string]string      `json:"headers,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+}
+
+// batchRequestWire is the wire format of a batch operation. The Graph API
+// expects the body as a form-encoded string.
+type batchRequestWire struct {
+	Method      string            `json:"method"`
+	RelativeURL string            `json:"relative_url"`
+	Body        json.RawMessage   `json:"body,omitempty"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	Name        string            `json:"name,omitempty"`
+}
+
+// MarshalJSON encodes the body as a form string, with nested values JSON-encoded
+func (r BatchRequest) MarshalJSON() ([]byte, error) {
+	wire := batchRequestWire{
+		Method:      r.Method,
+		RelativeURL: r.RelativeURL,
+		Headers:     r.Headers,
+		Name:        r.Name,
+	}
+	if len(r.Body) > 0 {
+		form, err := EncodeForm(r.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode body of %s %s: %w", r.Method, r.RelativeURL, err)
+		}
+		if wire.Body, err = json.Marshal(form.Encode()); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(wire)
+}
+
+// UnmarshalJSON accepts the body either as a form string or as an object
+func (r *BatchRequest) UnmarshalJSON(data []byte) error {
+	var wire batchRequestWire
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	*r = BatchRequest{
+		Method:      wire.Method,
+		RelativeURL: wire.RelativeURL,
+		Headers:     wire.Headers,
+		Name:        wire.Name,
+	}
+	if len(wire.Body) == 0 || string(wire.Body) == "null" {
+		return nil
+	}
+
+	var encoded string
+	if err := json.Unmarshal(wire.Body, &encoded); err != nil {
+		return json.Unmarshal(wire.Body, &r.Body)
+	}
+	form, err := url.ParseQuery(encoded)
+	if err != nil {
+		return fmt.Errorf("invalid batch body: %w", err)
+	}
+	r.Body = make(map[string]interface{}, len(form))
+	for key := range form {
+		r.Body[key] = form.Get(key)
+	}
+	return nil
+}
+
+// batchOperations decodes the operations of a batch request from its
+// parameters. Operations that cannot be decoded are returned empty, so indexes
+// match the batch and an empty method is never mistaken for a GET.
+func batchOperations(params map[string]interface{}) []BatchRequest {
+	raw, _ := params["batch"].([]interface{})
+	operations := make([]BatchRequest, len(raw))
+	for i, op := range raw {
+		encoded, err := json.Marshal(op)
+		if err != nil {
+			continue
+		}
+		_ = json.Unmarshal(encoded, &operations[i])
+	}
+	return operations
+}
+
+// Target returns the path the operation is sent to and its parameters, merged
+// from the relative URL's query string and the body. JSON-encoded values are
+// decoded.
+func (r BatchRequest) Target() (string, map[string]interface{}) {
+	path, rawQuery, _ := strings.Cut(r.RelativeURL, "?")
+	params := make(map[string]interface{})
+	if query, err := url.ParseQuery(rawQuery); err == nil {
+		for key := range query {
+			params[key] = decodeAuditValue(query.Get(key))
+		}
+	}
+	for key, value := range r.Body {
+		if s, ok := value.(string); ok {
+			value = decodeAuditValue(s)
+		}
+		params[key] = value
+	}
+	return path, params
+}
+
+// BatchResponse represents the response from a single batch operation
+type BatchResponse struct {
+	Code    int               `json:"code"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
+// Batch performs a batch request to the Facebook Graph API
+func (c *Client) Batch(ctx context.Context, requests []BatchRequest) ([]BatchResponse, error) {
+	if len(requests) == 0 {
+		return nil, fmt.Errorf("no requests provided")
+	}
+	if len(requests) > MaxBatchSize {
+		return nil, fmt.Errorf("batch request limited to %d requests, got %d", MaxBatchSize, len(requests))
+	}
+
+	// Convert batch requests to JSON
+	batchJSON, err := json.Marshal(requests)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal batch requests: %w", err)
+	}
+
+	log.Printf("[DEBUG] Making batch request with %d requests", len(requests))
+
+	resp, err := c.Do(ctx, &Request{
+		Method:      http.MethodPost,
+		Form:        url.Values{"batch": {string(batchJSON)}},
+		TokenInBody: true,
+		Idempotent:  readOnlyBatch(requests),
+	})
+	if err != nil {
+		// HTTPError redacts its body
+		return nil, fmt.Errorf("batch request failed: %w", err)
+	}
+
+	// Parse response
+	var batchResponses []BatchResponse
+	if err := json.Unmarshal(resp.Body, &batchResponses); err != nil {
+		return nil, fmt.Errorf("failed to parse batch response: %w", err)
+	}
+
+	return batchResponses, nil
+}
+
+// readOnlyBatch reports whether every request in the batch is a GET, making the
+// whole batch safe to replay
+func readOnlyBatch(requests []BatchRequest) bool {
+	for _, req := range requests {
+		if req.Method != "" && req.Method != http.MethodGet {
+			return false
+		}
+	}
+	return true
+}
@@ -1,54 +1,476 @@
 package facebook
 
 import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
 	"unified-ads-mcp/internal/config"
+	"unified-ads-mcp/internal/shared"
+)
+
+// Graph API defaults
+const (
+	DefaultAPIVersion = "v23.0"
+	DefaultGraphURL   = "https://graph.facebook.com"
+	DefaultVideoURL   = "https://graph-video.facebook.com"
 )
 
+// TokenSource resolves the access token to use for a request
+type TokenSource func(ctx context.Context) (string, error)
+
+// RoundTripFunc executes a single HTTP request against the Graph API
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps a RoundTripFunc to add behaviour around every Graph API request
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// RequestCheck rejects a request before it is sent by returning an error
+type RequestCheck func(req *http.Request) error
+
+// rejectedError wraps the error of a request check. The request was never
+// sent, so it is not retried.
+type rejectedError struct {
+	err error
+}
+
+// Error implements the error interface
+func (e *rejectedError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the error of the check
+func (e *rejectedError) Unwrap() error {
+	return e.err
+}
+
+// Client is the single entry point for Facebook Graph API traffic. It owns the
+// HTTP transport, token source, API version, base URLs and middleware chain used
+// by generated tools, batch tools and the video uploader.
 type Client struct {
-	config *config.FacebookConfig
+	httpClient  *http.Client
+	tokenSource TokenSource
+	apiVersion  string
+	graphURL    string
+	videoURL    string
+	middleware  []Middleware
+	checks      []RequestCheck
+	rateLimiter *RateLimiter
+	retryPolicy RetryPolicy
+	appSecret   string
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to execute requests
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// WithTransport sets the HTTP transport used to execute requests
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) {
+		hc := *c.httpClient
+		hc.Transport = rt
+		c.httpClient = &hc
+	}
+}
+
+// WithTokenSource sets how access tokens are resolved
+func WithTokenSource(ts TokenSource) Option {
+	return func(c *Client) {
+		c.tokenSource = ts
+	}
+}
+
+// WithAccessToken uses a fixed access token unless the request context carries one
+func WithAccessToken(token string) Option {
+	return WithTokenSource(contextTokenSource(token))
+}
+
+// WithAPIVersion sets the Graph API version, e.g. "v23.0"
+func WithAPIVersion(version string) Option {
+	return func(c *Client) {
+		c.apiVersion = version
+	}
+}
+
+// WithGraphURL sets the Graph API base URL
+func WithGraphURL(baseURL string) Option {
+	return func(c *Client) {
+		c.graphURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithVideoURL sets the Graph Video API base URL used for chunked uploads
+func WithVideoURL(baseURL string) Option {
+	return func(c *Client) {
+		c.videoURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithAppSecret signs every request with appsecret_proof derived from the app secret
+func WithAppSecret(secret string) Option {
+	return func(c *Client) {
+		c.appSecret = secret
+		shared.RegisterSecret(secret)
+	}
+}
+
+// WithMiddleware appends middleware to the request chain. The first middleware
+// added is the outermost one.
+func WithMiddleware(mw ...Middleware) Option {
+	return func(c *Client) {
+		c.middleware = append(c.middleware, mw...)
+	}
+}
+
+// WithRequestCheck adds checks that run once per request, on its first
+// attempt; retries of a request that passed are not checked again. Checks run
+// inside the middleware chain, so middleware such as the audit log sees the
+// requests they reject.
+func WithRequestCheck(checks ...RequestCheck) Option {
+	return func(c *Client) {
+		c.checks = append(c.checks, checks...)
+	}
+}
+
+// NewClient creates a Graph API client from configuration and options
+func NewClient(cfg *config.FacebookConfig, opts ...Option) *Client {
+	token, appSecret, apiVersion := "", "", DefaultAPIVersion
+	if cfg != nil {
+		token = cfg.AccessToken
+		appSecret = cfg.AppSecret
+		if cfg.APIVersion != "" {
+			apiVersion = cfg.APIVersion
+		}
+	}
+
+	c := &Client{
+		httpClient:  &http.Client{},
+		tokenSource: contextTokenSource(token),
+		apiVersion:  apiVersion,
+		graphURL:    DefaultGraphURL,
+		videoURL:    DefaultVideoURL,
+		rateLimiter: NewRateLimiter(),
+		retryPolicy: DefaultRetryPolicy(),
+		appSecret:   appSecret,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	shared.RegisterSecret(token)
+	shared.RegisterSecret(c.appSecret)
+	return c
+}
+
+// With returns a copy of the client with additional options applied
+func (c *Client) With(opts ...Option) *Client {
+	clone := *c
+	clone.middleware = append([]Middleware(nil), c.middleware...)
+	clone.checks = append([]RequestCheck(nil), c.checks...)
+	for _, opt := range opts {
+		opt(&clone)
+	}
+	return &clone
 }
 
-func NewClient(cfg *config.FacebookConfig) *Client {
-	return &Client{
-		config: cfg,
+// APIVersion returns the Graph API version used by the client
+func (c *Client) APIVersion() string {
+	return c.apiVersion
+}
+
+// GraphHost returns the Graph API base URL used by the client
+func (c *Client) GraphHost() string {
+	return c.graphURL
+}
+
+// GraphURL resolves a Graph API path such as "/123/insights" into an absolute
+// URL on the configured host and API version. Absolute URLs are returned as-is.
+func (c *Client) GraphURL(path string) string {
+	return c.resolveURL(path, false)
+}
+
+func (c *Client) resolveURL(path string, video bool) string {
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		return path
+	}
+	base := c.graphURL
+	if video {
+		base = c.videoURL
+	}
+	return fmt.Sprintf("%s/%s/%s", base, c.apiVersion, strings.TrimPrefix(path, "/"))
+}
+
+// AccessToken resolves the access token for the given context
+func (c *Client) AccessToken(ctx context.Context) (string, error) {
+	return c.tokenSource(ctx)
+}
+
+// contextTokenSource prefers a token carried in the context, then the fallback
+// token, then the FACEBOOK_ACCESS_TOKEN environment variable
+func contextTokenSource(fallback string) TokenSource {
+	return func(ctx context.Context) (string, error) {
+		if token, ok := shared.FacebookAccessTokenFromContext(ctx); ok && token != "" {
+			return token, nil
+		}
+		if fallback != "" {
+			return fallback, nil
+		}
+		if token := os.Getenv("FACEBOOK_ACCESS_TOKEN"); token != "" {
+			return token, nil
+		}
+		return "", fmt.Errorf("FACEBOOK_ACCESS_TOKEN environment variable is not set")
+	}
+}
+
+// AppSecretProof returns the hex-encoded HMAC-SHA256 of the access token keyed
+// with the app secret, as required by apps with "Require App Secret" enabled
+func AppSecretProof(token, appSecret string) string {
+	mac := hmac.New(sha256.New, []byte(appSecret))
+	mac.Write([]byte(token))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Request describes a single Graph API call
+type Request struct {
+	// Method is the HTTP method (GET, POST, DELETE, ...)
+	Method string
+	// Path is relative to the API version (e.g. "act_123/campaigns") or an absolute URL
+	Path string
+	// Query holds URL query parameters
+	Query url.Values
+	// Form holds form-encoded body parameters
+	Form url.Values
+	// TokenInBody sends the access token as a form field instead of the
+	// Authorization header. Batch requests need it. Requires Form.
+	TokenInBody bool
+	// Body is a raw request body, sent with ContentType. Ignored when Form is set.
+	Body io.Reader
+	// ContentType of Body
+	ContentType string
+	// Video targets the Graph Video API host instead of the Graph API host
+	Video bool
+	// Idempotent marks a non-GET request as safe to replay after an ambiguous failure
+	Idempotent bool
+}
+
+// Response is a successful Graph API response
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	// Retries is the number of retries needed before the request succeeded
+	Retries int
+}
+
+// HTTPError is returned when the Graph API responds with a non-2xx status and
+// the body is not a Graph API error object
+type HTTPError struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Error implements the error interface
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("HTTP %d: %s", e.StatusCode, shared.Redact(string(e.Body)))
+}
+
+// Do executes a Graph API request, honoring cancellation of ctx. The access
+// token is sent in the Authorization header so it never appears in URLs;
+// batch requests carry it in the form body instead.
+func (c *Client) Do(ctx context.Context, r *Request) (*Response, error) {
+	token, err := c.tokenSource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	shared.RegisterSecret(token)
+
+	urlStr := c.withAPIVersion(c.resolveURL(r.Path, r.Video), c.APIVersionFor(ctx))
+
+	// Test guardrail: panic if hitting real Facebook API during tests
+	if strings.Contains(urlStr, "facebook.com") && os.Getenv("TESTING") == "true" {
+		panic(fmt.Sprintf("TEST GUARDRAIL: Attempted to hit real Facebook API during tests! URL: %s", urlStr))
+	}
+
+	parsedURL, err := url.Parse(urlStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %w", err)
+	}
+	q := parsedURL.Query()
+	for key, values := range r.Query {
+		q[key] = values
+	}
+
+	var body []byte
+	var authorization string
+	contentType := r.ContentType
+	if r.Form != nil {
+		form := url.Values{}
+		for key, values := range r.Form {
+			form[key] = values
+		}
+		if r.TokenInBody {
+			form.Set("access_token", token)
+			if c.appSecret != "" {
+				form.Set("appsecret_proof", AppSecretProof(token, c.appSecret))
+			}
+		} else {
+			authorization = "Bearer " + token
+			if c.appSecret != "" {
+				q.Set("appsecret_proof", AppSecretProof(token, c.appSecret))
+			}
+		}
+		body = []byte(form.Encode())
+		contentType = "application/x-www-form-urlencoded"
+	} else {
+		q.Del("access_token")
+		authorization = "Bearer " + token
+		if c.appSecret != "" {
+			q.Set("appsecret_proof", AppSecretProof(token, c.appSecret))
+		}
+		if r.Body != nil {
+			// Buffer the body so it can be replayed on retry
+			if body, err = io.ReadAll(r.Body); err != nil {
+				return nil, fmt.Errorf("failed to read request body: %w", err)
+			}
+		}
+	}
+	parsedURL.RawQuery = q.Encode()
+
+	for retry := 0; ; retry++ {
+		resp, err := c.send(ctx, r.Method, parsedURL.String(), body, contentType, authorization, retry == 0)
+		if err == nil {
+			resp.Retries = retry
+			return resp, nil
+		}
+
+		decision := classifyError(err)
+		if retry >= c.retryPolicy.MaxRetries || !decision.retryable || (!decision.safe && !isIdempotent(r)) {
+			return nil, err
+		}
+		if waitErr := c.retryAttempt(ctx, r, retry+1, err); waitErr != nil {
+			return nil, err
+		}
 	}
 }
 
-func (c *Client) CreateCampaign(args map[string]interface{}) (interface{}, error) {
-	name, ok := args["name"].(string)
-	if !ok {
-		return nil, fmt.Errorf("missing campaign name")
+// send performs a single HTTP attempt. Request checks run on the first
+// attempt only.
+func (c *Client) send(ctx context.Context, method, urlStr string, body []byte, contentType, authorization string, firstAttempt bool) (*Response, error) {
+	log.Printf("[DEBUG] Making %s request to %s", method, shared.Redact(urlStr))
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, urlStr, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	resp, err := c.roundTrip(firstAttempt)(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
 	}
+	defer resp.Body.Close()
 
-	objective, ok := args["objective"].(string)
-	if !ok {
-		return nil, fmt.Errorf("missing campaign objective")
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
-	budget, ok := args["budget"].(float64)
-	if !ok {
-		return nil, fmt.Errorf("missing campaign budget")
+	if resp.StatusCode >= 400 {
+		if graphErr := ParseGraphError(resp.StatusCode, respBody); graphErr != nil {
+			return nil, graphErr
+		}
+		return nil, &HTTPError{StatusCode: resp.StatusCode, Header: resp.Header, Body: respBody}
 	}
 
-	campaign := map[string]interface{}{
-		"id":        fmt.Sprintf("facebook_campaign_%d", generateID()),
-		"name":      name,
-		"objective": objective,
-		"budget":    budget,
-		"status":    "PAUSED",
-		"platform":  "facebook_ads",
+	return &Response{StatusCode: resp.StatusCode, Header: resp.Header, Body: respBody}, nil
+}
+
+// roundTrip builds the middleware chain around the HTTP client. The rate
+// limiter sits closest to the transport so every attempt is paced; request
+// checks sit just outside it when check is set.
+func (c *Client) roundTrip(check bool) RoundTripFunc {
+	rt := RoundTripFunc(c.httpClient.Do)
+	if c.rateLimiter != nil {
+		rt = c.rateLimiter.Middleware()(rt)
+	}
+	if check && len(c.checks) > 0 {
+		next := rt
+		rt = func(req *http.Request) (*http.Response, error) {
+			for _, check := range c.checks {
+				if err := check(req); err != nil {
+					return nil, &rejectedError{err: err}
+				}
+			}
+			return next(req)
+		}
+	}
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		rt = c.middleware[i](rt)
 	}
+	return rt
+}
+
+// Get performs a GET request with query parameters
+func (c *Client) Get(ctx context.Context, path string, query url.Values) (*Response, error) {
+	return c.Do(ctx, &Request{Method: http.MethodGet, Path: path, Query: query})
+}
 
-	return campaign, nil
+// PostJSON performs a POST request with a JSON encoded body
+func (c *Client) PostJSON(ctx context.Context, path string, data interface{}) (*Response, error) {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+	return c.Do(ctx, &Request{
+		Method:      http.MethodPost,
+		Path:        path,
+		Body:        bytes.NewReader(jsonData),
+		ContentType: "application/json",
+	})
 }
 
-func generateID() int64 {
-	return 67890
+// Default client shared by tools that are registered as plain handler functions
+var (
+	defaultMu     sync.RWMutex
+	defaultClient = NewClient(nil)
+)
+
+// DefaultClient returns the process-wide Graph API client
+func DefaultClient() *Client {
+	defaultMu.RLock()
+	defer defaultMu.RUnlock()
+	return defaultClient
 }
 
-// GetMCPTools returns all available MCP tools for Facebook Business API
-func (c *Client) GetMCPTools() []map[string]interface{} {
-	// This method is deprecated, use GetMCPToolsV2 for mcp-go compatibility
-	return []map[string]interface{}{}
+// SetDefaultClient replaces the process-wide Graph API client
+func SetDefaultClient(c *Client) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultClient = c
 }
@@ -0,0 +1,217 @@
+package facebook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"unified-ads-mcp/internal/config"
+	"unified-ads-mcp/internal/facebook/testutil"
+	"unified-ads-mcp/internal/shared"
+)
+
+func init() {
+	// Set testing environment variable to enable guardrails
+	os.Setenv("TESTING", "true")
+}
+
+func TestClient_Do_UsesContextToken(t *testing.T) {
+	env := testutil.NewTestEnvironment(t)
+	defer env.Teardown()
+
+	var gotToken string
+	env.Server().AddRoute("GET", "/"+DefaultAPIVersion+"/me/adaccounts", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("access_token") != "" {
+			t.Error("Expected no access_token in the URL")
+		}
+		gotToken = testutil.BearerToken(r)
+		env.Server().WriteSuccess(w, map[string]interface{}{"data": []interface{}{}})
+	})
+
+	client := NewClient(nil, WithGraphURL(env.Server().URL), WithAccessToken("configured_token"))
+	ctx := shared.WithFacebookAccessToken(context.Background(), "context_token")
+
+	if _, err := client.Get(ctx, "me/adaccounts", nil); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if gotToken != "context_token" {
+		t.Errorf("Expected context token to take precedence, got %q", gotToken)
+	}
+}
+
+func TestClient_Do_MiddlewareAndGraphError(t *testing.T) {
+	env := testutil.NewTestEnvironment(t)
+	defer env.Teardown()
+
+	env.Server().AddRoute("GET", "/"+DefaultAPIVersion+"/"+testutil.TestCampaignID, func(w http.ResponseWriter, r *http.Request) {
+		env.Server().WriteError(w, http.StatusBadRequest, "OAuthException", "Invalid parameter")
+	})
+
+	calls := 0
+	counter := func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			calls++
+			return next(req)
+		}
+	}
+
+	client := NewClient(nil, WithGraphURL(env.Server().URL), WithAccessToken("test_token"), WithMiddleware(counter))
+	_, err := client.Get(context.Background(), testutil.TestCampaignID, nil)
+
+	graphErr, ok := err.(*GraphError)
+	if !ok {
+		t.Fatalf("Expected *GraphError, got %T: %v", err, err)
+	}
+	if graphErr.HTTPStatus != http.StatusBadRequest || graphErr.Type != "OAuthException" || graphErr.Message != "Invalid parameter" {
+		t.Errorf("Unexpected graph error: %+v", graphErr)
+	}
+	if calls != 1 {
+		t.Errorf("Expected middleware to run once, ran %d times", calls)
+	}
+}
+
+func TestClient_Batch_SendsFormToken(t *testing.T) {
+	env := testutil.NewTestEnvironment(t)
+	defer env.Teardown()
+
+	var gotBatch, gotToken string
+	env.Server().AddRoute("POST", "/"+DefaultAPIVersion+"/", func(w http.ResponseWriter, r *http.Request) {
+		gotBatch = r.FormValue("batch")
+		gotToken = r.FormValue("access_token")
+		env.Server().WriteSuccess(w, []map[string]interface{}{{"code": 200, "body": "{}"}})
+	})
+
+	client := NewClient(nil, WithGraphURL(env.Server().URL), WithAccessToken("test_token"))
+	responses, err := client.Batch(context.Background(), []BatchRequest{{Method: "GET", RelativeURL: testutil.TestCampaignID}})
+	if err != nil {
+		t.Fatalf("Batch failed: %v", err)
+	}
+	if len(responses) != 1 || responses[0].Code != 200 {
+		t.Errorf("Unexpected batch responses: %+v", responses)
+	}
+	if gotToken != "test_token" {
+		t.Errorf("Expected form access_token, got %q", gotToken)
+	}
+	if gotBatch == "" {
+		t.Error("Expected batch form field to be sent")
+	}
+}
+
+func TestClient_Batch_RedactsHTTPError(t *testing.T) {
+	env := testutil.NewTestEnvironment(t)
+	defer env.Teardown()
+
+	const token = "EAAsecretbatchtoken1234567890"
+	env.Server().AddRoute("POST", "/"+DefaultAPIVersion+"/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("upstream failed for access_token=" + token + " user jane@example.com"))
+	})
+
+	client := NewClient(nil, WithGraphURL(env.Server().URL), WithAccessToken(token))
+	_, err := client.Batch(context.Background(), []BatchRequest{{Method: "POST", RelativeURL: testutil.TestCampaignID, Body: map[string]interface{}{"name": "Sale"}}})
+	if err == nil {
+		t.Fatal("Expected the batch to fail")
+	}
+	for _, leaked := range []string{token, "jane@example.com"} {
+		if strings.Contains(err.Error(), leaked) {
+			t.Errorf("Expected %q to be redacted, got %v", leaked, err)
+		}
+	}
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusBadGateway {
+		t.Errorf("Expected the HTTP error to be wrapped, got %v", err)
+	}
+}
+
+func TestParseGraphError(t *testing.T) {
+	body := `{"error":{"message":"Invalid parameter","type":"OAuthException","code":100,"error_subcode":1487390,` +
+		`"error_user_title":"Invalid Targeting Spec","error_user_msg":"Targeting is too narrow","fbtrace_id":"AbC123",` +
+		`"error_data":"{\"blame_field_specs\":[[\"targeting\"]]}"}}`
+
+	graphErr := ParseGraphError(http.StatusBadRequest, []byte(body))
+	if graphErr == nil {
+		t.Fatal("Expected graph error")
+	}
+	if graphErr.Code != 100 || graphErr.ErrorSubcode != 1487390 || graphErr.FBTraceID != "AbC123" {
+		t.Errorf("Unexpected fields: %+v", graphErr)
+	}
+	if graphErr.ErrorUserTitle != "Invalid Targeting Spec" || graphErr.ErrorUserMsg != "Targeting is too narrow" {
+		t.Errorf("Unexpected user fields: %+v", graphErr)
+	}
+	if _, ok := graphErr.Data()["blame_field_specs"]; !ok {
+		t.Errorf("Expected string-encoded error_data to be decoded, got %v", graphErr.Data())
+	}
+
+	// Batch responses carry the body as a JSON-encoded string
+	encoded, _ := json.Marshal(body)
+	if batchErr := ParseGraphError(http.StatusBadRequest, encoded); batchErr == nil || batchErr.Code != 100 {
+		t.Errorf("Expected graph error from encoded body, got %+v", batchErr)
+	}
+
+	if ParseGraphError(http.StatusBadGateway, []byte("<html>Bad Gateway</html>")) != nil {
+		t.Error("Expected nil for non-Graph body")
+	}
+}
+
+func TestClient_AppSecretProof(t *testing.T) {
+	env := testutil.NewTestEnvironment(t)
+	defer env.Teardown()
+
+	const (
+		contextTokenProof = "b3f910efe8d9648804568f685347aab12e54fb4e9afffae5073ba3177669c871"
+		testTokenProof    = "5ff35fd6e89c685d72355cadd050b04129dbe252134626530475716df675d7f1"
+	)
+
+	var gotQueryProof, gotFormProof, gotVideoProof string
+	env.Server().AddRoute("GET", "/"+DefaultAPIVersion+"/me/adaccounts", func(w http.ResponseWriter, r *http.Request) {
+		gotQueryProof = r.URL.Query().Get("appsecret_proof")
+		env.Server().WriteSuccess(w, map[string]interface{}{"data": []interface{}{}})
+	})
+	env.Server().AddRoute("POST", "/"+DefaultAPIVersion+"/", func(w http.ResponseWriter, r *http.Request) {
+		gotFormProof = r.FormValue("appsecret_proof")
+		env.Server().WriteSuccess(w, []map[string]interface{}{{"code": 200, "body": "{}"}})
+	})
+	env.Server().AddRoute("POST", "/"+DefaultAPIVersion+"/act_123/advideos", func(w http.ResponseWriter, r *http.Request) {
+		gotVideoProof = r.URL.Query().Get("appsecret_proof")
+		env.Server().WriteSuccess(w, map[string]interface{}{"start_offset": "0", "end_offset": "0"})
+	})
+
+	client := NewClient(&config.FacebookConfig{AccessToken: "test_token", AppSecret: "app_secret"},
+		WithGraphURL(env.Server().URL), WithVideoURL(env.Server().URL))
+
+	// Tokens supplied through the context are signed too
+	ctx := shared.WithFacebookAccessToken(context.Background(), "context_token")
+	if _, err := client.Get(ctx, "me/adaccounts", nil); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if gotQueryProof != contextTokenProof {
+		t.Errorf("Expected proof for context token, got %q", gotQueryProof)
+	}
+
+	if _, err := client.Batch(context.Background(), []BatchRequest{{Method: "GET", RelativeURL: "me"}}); err != nil {
+		t.Fatalf("Batch failed: %v", err)
+	}
+	if gotFormProof != testTokenProof {
+		t.Errorf("Expected proof in batch form, got %q", gotFormProof)
+	}
+
+	if _, err := client.Do(context.Background(), &Request{Method: "POST", Path: "act_123/advideos", Video: true}); err != nil {
+		t.Fatalf("Video request failed: %v", err)
+	}
+	if gotVideoProof != testTokenProof {
+		t.Errorf("Expected proof on video request, got %q", gotVideoProof)
+	}
+
+	// Without an app secret no proof is sent
+	gotQueryProof = ""
+	if _, err := NewClient(nil, WithGraphURL(env.Server().URL)).Get(context.Background(), "me/adaccounts", nil); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if gotQueryProof != "" {
+		t.Errorf("Expected no proof without app secret, got %q", gotQueryProof)
+	}
+}
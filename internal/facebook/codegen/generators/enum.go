@@ -88,6 +88,8 @@ func (g *EnumGenerator) generateEnums() error {
 	type EnumGroup struct {
 		TypeName string
 		Enums    []EnumType
+		// Values is the union of the values of every enum sharing the type
+		Values []string
 	}
 	var orderedEnumGroups []EnumGroup
 
@@ -104,9 +106,20 @@ func (g *EnumGenerator) generateEnums() error {
 				}
 			}
 			enums[0].Values = filteredValues
+
+			allValues := append([]string(nil), filteredValues...)
+			for _, enum := range enums[1:] {
+				for _, value := range enum.Values {
+					if !uniqueValues[value] {
+						uniqueValues[value] = true
+						allValues = append(allValues, value)
+					}
+				}
+			}
 			orderedEnumGroups = append(orderedEnumGroups, EnumGroup{
 				TypeName: typeName,
 				Enums:    enums,
+				Values:   allValues,
 			})
 		}
 	}
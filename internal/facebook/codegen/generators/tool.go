@@ -9,6 +9,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 )
@@ -184,6 +185,26 @@ type ToolData struct {
 	HasQueryParams  bool          // Whether POST/PUT has query params
 	SchemaParams    []SchemaParam // For new template format
 	TypedParams     []TypedParam  // For typed handlers
+	IsList          bool          // Whether the tool lists a paginated edge
+	Rules           []ValidationRule
+	ReturnType      string // Struct in common/fields.go the response decodes into, if any
+	ReturnsList     bool   // Whether the response is a list edge of ReturnType
+	OutputSchema    string // Raw JSON schema for the tool's structured content
+	Title           string // Human-readable title annotation
+	Annotation      string // ReadOnly, Create, Update or Delete; selects common.<Annotation>Annotation
+	ValidateOnly    bool   // Whether dry runs can be sent with execution_options=["validate_only"]
+}
+
+// ValidationRule is an argument check emitted into a generated handler as a
+// common.ParamRule. Values are Go source expressions.
+type ValidationRule struct {
+	Name     string
+	Type     string
+	Required bool
+	Enum     string
+	Pattern  string
+	Minimum  string
+	Maximum  string
 }
 
 type ObjectGroup struct {
@@ -1048,8 +1069,26 @@ func (g *ToolGenerator) generateSingleToolFile(objectName string, api API, toolN
 		HasQueryParams:  g.hasQueryParams(api),
 		TypedParams:     g.convertToTypedParams(api.Params, api.Method),
 		SchemaParams:    g.convertToSchemaParams(api.Params, g.needsObjectID(objectName, api.Endpoint), api.Method),
+		IsList:          g.isListTool(objectName, api),
+		ReturnType:      g.returnStruct(api),
+		ReturnsList:     g.returnsList(api),
+		Title:           g.generateTitle(objectName, toolName),
+		Annotation:      g.operationKind(toolName, api),
 	}
 
+	outputSchema, err := g.generateOutputSchema(api)
+	if err != nil {
+		return fmt.Errorf("failed to generate output schema for %s: %w", toolName, err)
+	}
+	tool.OutputSchema = outputSchema
+
+	rules, err := g.generateValidationRules(api, rawSchema)
+	if err != nil {
+		return fmt.Errorf("failed to generate validation rules for %s: %w", toolName, err)
+	}
+	tool.Rules = rules
+	tool.ValidateOnly = supportsValidateOnly(api, rawSchema)
+
 	// Generate file name from tool name
 	fileName := strings.TrimPrefix(toolName, strings.ToLower(objectName)+"_")
 	fileName = strings.ReplaceAll(fileName, "_", "_")
@@ -1350,9 +1389,13 @@ func (g *ToolGenerator) convertToTypedParams(params []Param, method string) []Ty
 			JSONSchemaTag: g.generateJSONSchemaTag(param, goType),
 		}
 
-		// Add omitempty for optional params
+		// Add omitempty for optional params. Optional scalars are pointers so
+		// an explicit false or 0 is sent and an omitted argument is not.
 		if !param.Required {
 			typedParam.JSONTag = "omitempty"
+			if isScalarGoType(goType) {
+				typedParam.GoType = "*" + goType
+			}
 		}
 
 		typedParams = append(typedParams, typedParam)
@@ -1385,7 +1428,7 @@ func (g *ToolGenerator) generateJSONSchemaTag(param Param, goType string) string
 
 	case strings.Contains(goType, "int") || param.Type == "integer":
 		// Add reasonable bounds for common integer fields
-		if strings.Contains(param.Name, "age") {
+		if isAgeParam(param.Name) {
 			tags = append(tags, "minimum=13", "maximum=100")
 		} else if strings.Contains(param.Name, "budget") || strings.Contains(param.Name, "amount") {
 			tags = append(tags, "minimum=1")
@@ -1853,6 +1896,23 @@ func (g *ToolGenerator) generateRawSchema(objectName string, api API) (string, e
 	if api.Method == "GET" {
 		g.addCommonGetParams(properties)
 	}
+	if g.isListTool(objectName, api) {
+		g.addPaginationParams(properties)
+	}
+	if returnType := g.returnStruct(api); returnType != "" && api.Method == "GET" {
+		properties["structured"] = map[string]interface{}{
+			"type":        "boolean",
+			"description": fmt.Sprintf("Decode the response into typed %s objects, with RFC 3339 UTC timestamps, and return it as structured content", returnType),
+		}
+	}
+	if api.Method != "GET" {
+		g.addDryRunParam(properties)
+		g.addConfirmationParam(properties)
+	}
+	if g.operationKind(g.generateToolName(objectName, api), api) == "Create" {
+		g.addIdempotencyParam(properties)
+	}
+	g.addAPIVersionParam(properties)
 
 	// Update required array
 	schema["required"] = required
@@ -1885,7 +1945,7 @@ func (g *ToolGenerator) generateComplexParamSchemaWithCycleDetection(param Param
 	case strings.HasPrefix(param.Type, "map<"):
 		return g.generateMapSchemaWithCycleDetection(param, visited)
 
-	case param.Type == "Object" || param.Type == "object":
+	case param.Type == "Object" || param.Type == "object" || param.Type == "map":
 		schema["type"] = "object"
 		schema["additionalProperties"] = true
 
@@ -1933,6 +1993,11 @@ func (g *ToolGenerator) generateListSchemaWithCycleDetection(param Param, visite
 			"type":                 "object",
 			"additionalProperties": true,
 		}
+	} else if g.isEnumType(innerType) {
+		schema["items"] = map[string]interface{}{
+			"type": "string",
+			"enum": g.getEnumValues(innerType),
+		}
 	} else {
 		schema["items"] = map[string]interface{}{
 			"type": g.mapBasicType(innerType),
@@ -2124,6 +2189,231 @@ func (g *ToolGenerator) addCommonGetParams(properties map[string]interface{}) {
 	}
 }
 
+// generateTitle builds a human-readable title from the tool name, e.g.
+// "Ad Account: Create Campaign" for ad_account_create_campaign
+func (g *ToolGenerator) generateTitle(objectName, toolName string) string {
+	objectSnake := g.toSnakeCase(objectName)
+	action := strings.TrimPrefix(strings.TrimPrefix(toolName, objectSnake), "_")
+	return titleWords(objectSnake) + ": " + titleWords(action)
+}
+
+func titleWords(snake string) string {
+	words := strings.Split(snake, "_")
+	for i, word := range words {
+		if word != "" {
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// operationKind classifies a tool for its MCP annotations. POSTs that update
+// an existing object are destructive; POSTs that create are not.
+func (g *ToolGenerator) operationKind(toolName string, api API) string {
+	switch {
+	case api.Method == "GET":
+		return "ReadOnly"
+	case api.Method == "DELETE":
+		return "Delete"
+	case strings.Contains(toolName, "_update"):
+		return "Update"
+	default:
+		return "Create"
+	}
+}
+
+// returnStruct returns the generated field struct a tool's response decodes
+// into, or "" when there is none
+func (g *ToolGenerator) returnStruct(api API) string {
+	if _, ok := g.fieldSpecs[api.Return]; !ok {
+		return ""
+	}
+	return api.Return
+}
+
+// returnsList reports whether a tool reads a list edge, whose response wraps
+// the return type in a data array
+func (g *ToolGenerator) returnsList(api API) bool {
+	return api.Method == "GET" && api.Endpoint != ""
+}
+
+// generateOutputSchema derives the tool's output schema from its return
+// type's field spec. Nested objects are not expanded, and undeclared fields
+// are allowed since the response depends on the fields requested.
+func (g *ToolGenerator) generateOutputSchema(api API) (string, error) {
+	object := map[string]interface{}{
+		"type":                 "object",
+		"additionalProperties": true,
+	}
+	if returnType := g.returnStruct(api); returnType != "" {
+		properties := make(map[string]interface{})
+		for _, field := range g.fieldSpecs[returnType].Fields {
+			properties[field.Name] = g.outputFieldSchema(field.Type)
+		}
+		object["properties"] = properties
+	}
+
+	schema := object
+	if g.returnsList(api) {
+		schema = map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"data":       map[string]interface{}{"type": "array", "items": object},
+				"paging":     map[string]interface{}{"type": "object"},
+				"pagination": map[string]interface{}{"type": "object"},
+				"summary":    map[string]interface{}{"type": "object"},
+			},
+			"additionalProperties": true,
+		}
+	}
+
+	schemaJSON, err := json.Marshal(schema)
+	if err != nil {
+		return "", err
+	}
+	return string(schemaJSON), nil
+}
+
+// outputFieldSchema maps a field spec type to a JSON schema, mirroring the Go
+// types the field generator emits
+func (g *ToolGenerator) outputFieldSchema(fieldType string) map[string]interface{} {
+	switch {
+	case strings.HasPrefix(fieldType, "list<") && strings.HasSuffix(fieldType, ">"):
+		inner := strings.TrimSuffix(strings.TrimPrefix(fieldType, "list<"), ">")
+		return map[string]interface{}{"type": "array", "items": g.outputFieldSchema(inner)}
+	case strings.HasPrefix(fieldType, "map<"), fieldType == "map", fieldType == "Map",
+		fieldType == "Object", fieldType == "object", g.specs[fieldType] != nil:
+		return map[string]interface{}{"type": "object"}
+	case g.isEnumType(fieldType):
+		return map[string]interface{}{"type": "string"}
+	}
+
+	switch fieldType {
+	case "string":
+		return map[string]interface{}{"type": "string"}
+	case "int", "integer", "unsigned int", "uint":
+		return map[string]interface{}{"type": "integer"}
+	case "float", "double", "number":
+		return map[string]interface{}{"type": "number"}
+	case "bool", "boolean":
+		return map[string]interface{}{"type": "boolean"}
+	case "datetime", "timestamp":
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+// isScalarGoType reports whether a Go type has a zero value that is also a
+// meaningful argument (false, 0)
+func isScalarGoType(goType string) bool {
+	switch goType {
+	case "bool", "int", "int64", "float64":
+		return true
+	}
+	return false
+}
+
+// isListTool reports whether the API lists a paginated edge (e.g. ad_account_list_campaigns)
+func (g *ToolGenerator) isListTool(objectName string, api API) bool {
+	return api.Method == "GET" && strings.Contains(g.generateToolName(objectName, api), "_list_")
+}
+
+// addPaginationParams adds server-side pagination options to list tool schemas
+func (g *ToolGenerator) addPaginationParams(properties map[string]interface{}) {
+	properties["fetch_all"] = map[string]interface{}{
+		"type":        "boolean",
+		"description": "Follow paging.next and return all pages merged into one data array (subject to max_items/max_pages and a hard safety cap)",
+	}
+	properties["max_items"] = map[string]interface{}{
+		"type":        "integer",
+		"description": "Maximum number of items to return across pages",
+		"minimum":     1,
+		"maximum":     5000,
+	}
+	properties["max_pages"] = map[string]interface{}{
+		"type":        "integer",
+		"description": "Maximum number of pages to fetch",
+		"minimum":     1,
+		"maximum":     50,
+	}
+}
+
+// addAPIVersionParam adds the per-call Graph API version override. It is read
+// by the server middleware, not sent as a parameter.
+func (g *ToolGenerator) addAPIVersionParam(properties map[string]interface{}) {
+	if _, exists := properties["api_version"]; exists {
+		return
+	}
+	properties["api_version"] = map[string]interface{}{
+		"type":        "string",
+		"description": "Graph API version for this call, e.g. \"v23.0\" (defaults to FACEBOOK_API_VERSION)",
+		"pattern":     "^v[0-9]+\\.[0-9]+$",
+	}
+}
+
+// addDryRunParam adds the per-call dry-run flag to mutating tools
+func (g *ToolGenerator) addDryRunParam(properties map[string]interface{}) {
+	if _, exists := properties["dry_run"]; exists {
+		return
+	}
+	properties["dry_run"] = map[string]interface{}{
+		"type":        "boolean",
+		"description": "Do not apply the change. Where supported the Graph API validates it with execution_options=[\"validate_only\"]; otherwise the request that would be sent is returned.",
+	}
+}
+
+// addConfirmationParam adds the token that confirms a change the server asked
+// to be confirmed
+func (g *ToolGenerator) addConfirmationParam(properties map[string]interface{}) {
+	if _, exists := properties["confirmation_token"]; exists {
+		return
+	}
+	properties["confirmation_token"] = map[string]interface{}{
+		"type":        "string",
+		"description": "Token returned when the server asked for this change to be confirmed. Repeat the call with the same arguments and the token to apply it.",
+	}
+}
+
+// addIdempotencyParam adds the key that lets clients retry a create without
+// creating a second object
+func (g *ToolGenerator) addIdempotencyParam(properties map[string]interface{}) {
+	if _, exists := properties["idempotency_key"]; exists {
+		return
+	}
+	properties["idempotency_key"] = map[string]interface{}{
+		"type":        "string",
+		"minLength":   1,
+		"maxLength":   255,
+		"description": "Unique key for this create, e.g. a UUID. Retrying with the same key and arguments returns the original result instead of creating another object.",
+	}
+}
+
+// supportsValidateOnly reports whether a dry run of the tool can be sent to
+// the Graph API with execution_options=["validate_only"]. Only POSTs that
+// accept execution_options qualify; other tools are previewed locally.
+func supportsValidateOnly(api API, rawSchema string) bool {
+	if api.Method != "POST" {
+		return false
+	}
+	var schema struct {
+		Properties map[string]struct {
+			Items struct {
+				Enum []string `json:"enum"`
+			} `json:"items"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal([]byte(rawSchema), &schema); err != nil {
+		return false
+	}
+	for _, option := range schema.Properties["execution_options"].Items.Enum {
+		if option == "validate_only" {
+			return true
+		}
+	}
+	return false
+}
+
 // addTypeSpecificValidation adds type-specific validation to schema
 func (g *ToolGenerator) addTypeSpecificValidation(schema map[string]interface{}, param Param) {
 	switch {
@@ -2131,7 +2421,7 @@ func (g *ToolGenerator) addTypeSpecificValidation(schema map[string]interface{},
 		schema["pattern"] = "^[0-9]+$"
 
 	case param.Type == "integer" || param.Type == "int":
-		if strings.Contains(param.Name, "age") {
+		if isAgeParam(param.Name) {
 			schema["minimum"] = 13
 			schema["maximum"] = 100
 		} else if strings.Contains(param.Name, "budget") || strings.Contains(param.Name, "amount") {
@@ -2181,3 +2471,77 @@ func (g *ToolGenerator) isFieldRequired(fieldName, typeName string) bool {
 
 	return requiredFields[fieldName]
 }
+
+// isAgeParam reports whether a parameter is a targeting age, as opposed to
+// names that merely contain "age" such as page or percentage
+func isAgeParam(name string) bool {
+	return name == "age" || strings.HasPrefix(name, "age_") || strings.HasSuffix(name, "_age")
+}
+
+// generateValidationRules derives the handler's argument checks from its raw
+// schema so local validation enforces exactly what the tool advertises. Enum
+// values refer to the tables in common/enums.go.
+func (g *ToolGenerator) generateValidationRules(api API, rawSchema string) ([]ValidationRule, error) {
+	type propertySchema struct {
+		Type    string   `json:"type"`
+		Enum    []string `json:"enum"`
+		Pattern string   `json:"pattern"`
+		Minimum *float64 `json:"minimum"`
+		Maximum *float64 `json:"maximum"`
+		Items   *struct {
+			Enum []string `json:"enum"`
+		} `json:"items"`
+	}
+	var schema struct {
+		Properties map[string]propertySchema `json:"properties"`
+		Required   []string                  `json:"required"`
+	}
+	if err := json.Unmarshal([]byte(rawSchema), &schema); err != nil {
+		return nil, err
+	}
+
+	paramTypes := make(map[string]string, len(api.Params))
+	for _, param := range api.Params {
+		paramTypes[param.Name] = param.Type
+	}
+	required := make(map[string]bool, len(schema.Required))
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	rules := make([]ValidationRule, 0, len(names))
+	for _, name := range names {
+		prop := schema.Properties[name]
+		rule := ValidationRule{Name: name, Type: prop.Type, Required: required[name]}
+
+		enumType := strings.TrimSuffix(strings.TrimPrefix(paramTypes[name], "list<"), ">")
+		values := prop.Enum
+		if prop.Items != nil && len(prop.Items.Enum) > 0 {
+			values = prop.Items.Enum
+		}
+		if len(values) > 0 {
+			if g.isEnumType(enumType) {
+				rule.Enum = "common." + ToGoTypeName(enumType) + "Values"
+			} else {
+				rule.Enum = fmt.Sprintf("%#v", values)
+			}
+		}
+		if prop.Pattern != "" {
+			rule.Pattern = strconv.Quote(prop.Pattern)
+		}
+		if prop.Minimum != nil {
+			rule.Minimum = strconv.FormatFloat(*prop.Minimum, 'f', -1, 64)
+		}
+		if prop.Maximum != nil {
+			rule.Maximum = strconv.FormatFloat(*prop.Maximum, 'f', -1, 64)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
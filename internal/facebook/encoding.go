@@ -0,0 +1,228 @@
+package facebook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// BodyEncoding selects how request parameters are written to the body
+type BodyEncoding int
+
+const (
+	// EncodingAuto picks multipart when a File is attached, JSON for
+	// endpoints that require it, and form encoding otherwise
+	EncodingAuto BodyEncoding = iota
+	// EncodingForm sends application/x-www-form-urlencoded. Nested objects
+	// and arrays are JSON-encoded strings, as the Graph API expects.
+	EncodingForm
+	// EncodingMultipart sends multipart/form-data, required for file uploads
+	EncodingMultipart
+	// EncodingJSON sends the parameters as a JSON object
+	EncodingJSON
+)
+
+// File is a parameter uploaded as a multipart file part
+type File struct {
+	Filename    string
+	ContentType string
+	Content     io.Reader
+}
+
+// jsonBodyEndpoints are path suffixes whose payloads are sent as JSON. The
+// Conversions API takes arrays of events that are awkward to form-encode.
+var jsonBodyEndpoints = []string{"/events"}
+
+// ChooseEncoding returns the encoding used for params posted to path
+func ChooseEncoding(path string, params map[string]interface{}) BodyEncoding {
+	for _, value := range params {
+		if _, ok := value.(*File); ok {
+			return EncodingMultipart
+		}
+	}
+	if u, err := url.Parse(path); err == nil {
+		path = u.Path
+	}
+	for _, suffix := range jsonBodyEndpoints {
+		if strings.HasSuffix(strings.TrimSuffix(path, "/"), suffix) {
+			return EncodingJSON
+		}
+	}
+	return EncodingForm
+}
+
+// FormValue converts a parameter to its Graph API form representation.
+// Strings, numbers and booleans are written as-is; objects and arrays are
+// JSON-encoded. ok is false for nil values, which are not sent.
+func FormValue(value interface{}) (encoded string, ok bool, err error) {
+	v := reflect.ValueOf(value)
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return "", false, nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return "", false, nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true, nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true, nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), true, nil
+	case reflect.Map, reflect.Slice:
+		if v.IsNil() {
+			return "", false, nil
+		}
+	}
+
+	encodedJSON, err := json.Marshal(v.Interface())
+	if err != nil {
+		return "", false, err
+	}
+	return string(encodedJSON), true, nil
+}
+
+// EncodeForm converts params to form values
+func EncodeForm(params map[string]interface{}) (url.Values, error) {
+	form := url.Values{}
+	for key, value := range params {
+		encoded, ok, err := FormValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s: %w", key, err)
+		}
+		if ok {
+			form.Set(key, encoded)
+		}
+	}
+	return form, nil
+}
+
+// EncodeBody encodes params with the given encoding and returns the body and
+// its content type
+func EncodeBody(encoding BodyEncoding, params map[string]interface{}) ([]byte, string, error) {
+	switch encoding {
+	case EncodingJSON:
+		body, err := json.Marshal(params)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to marshal data: %w", err)
+		}
+		return body, "application/json", nil
+
+	case EncodingMultipart:
+		return encodeMultipart(params)
+
+	default:
+		form, err := EncodeForm(params)
+		if err != nil {
+			return nil, "", err
+		}
+		return []byte(form.Encode()), "application/x-www-form-urlencoded", nil
+	}
+}
+
+func encodeMultipart(params map[string]interface{}) ([]byte, string, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	// Sorted so the body is deterministic
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if file, ok := params[key].(*File); ok {
+			if err := writeFilePart(writer, key, file); err != nil {
+				return nil, "", err
+			}
+			continue
+		}
+		encoded, ok, err := FormValue(params[key])
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to encode %s: %w", key, err)
+		}
+		if !ok {
+			continue
+		}
+		if err := writer.WriteField(key, encoded); err != nil {
+			return nil, "", fmt.Errorf("failed to write %s: %w", key, err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to close multipart body: %w", err)
+	}
+	return buf.Bytes(), writer.FormDataContentType(), nil
+}
+
+var multipartEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func writeFilePart(writer *multipart.Writer, key string, file *File) error {
+	contentType := file.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+		multipartEscaper.Replace(key), multipartEscaper.Replace(file.Filename)))
+	header.Set("Content-Type", contentType)
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return fmt.Errorf("failed to create file part %s: %w", key, err)
+	}
+	if _, err := io.Copy(part, file.Content); err != nil {
+		return fmt.Errorf("failed to write file part %s: %w", key, err)
+	}
+	return nil
+}
+
+// SendParams sends params in the request body, encoded as ChooseEncoding
+// decides for the path
+func (c *Client) SendParams(ctx context.Context, method, path string, params map[string]interface{}) (*Response, error) {
+	return c.SendParamsWithEncoding(ctx, method, path, params, EncodingAuto)
+}
+
+// SendParamsWithEncoding sends params in the request body with an explicit encoding
+func (c *Client) SendParamsWithEncoding(ctx context.Context, method, path string, params map[string]interface{}, encoding BodyEncoding) (*Response, error) {
+	if encoding == EncodingAuto {
+		encoding = ChooseEncoding(path, params)
+	}
+
+	if encoding == EncodingForm {
+		form, err := EncodeForm(params)
+		if err != nil {
+			return nil, err
+		}
+		return c.Do(ctx, &Request{Method: method, Path: path, Form: form})
+	}
+
+	body, contentType, err := EncodeBody(encoding, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.Do(ctx, &Request{
+		Method:      method,
+		Path:        path,
+		Body:        bytes.NewReader(body),
+		ContentType: contentType,
+	})
+}
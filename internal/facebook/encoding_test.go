@@ -0,0 +1,160 @@
+package facebook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"unified-ads-mcp/internal/facebook/testutil"
+)
+
+// captureBody records the content type and raw body of requests to path
+func captureBody(t *testing.T, env *testutil.TestEnvironment, path string) (*string, *string) {
+	t.Helper()
+	var contentType, body string
+	env.Server().AddRoute("POST", path, func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		raw, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("Failed to read body: %v", err)
+		}
+		body = string(raw)
+		env.Server().WriteSuccess(w, map[string]interface{}{"id": testutil.TestAdsetID})
+	})
+	return &contentType, &body
+}
+
+func TestSendParams_FormWireFormat(t *testing.T) {
+	env := testutil.NewTestEnvironment(t)
+	defer env.Teardown()
+
+	contentType, body := captureBody(t, env, "/"+DefaultAPIVersion+"/act_123/adsets")
+	bidAmount := 0
+	client := NewClient(nil, WithGraphURL(env.Server().URL))
+
+	_, err := client.SendParams(context.Background(), http.MethodPost, "act_123/adsets", map[string]interface{}{
+		"name":         "Spring Sale",
+		"daily_budget": float64(5000),
+		"bid_amount":   &bidAmount,
+		"is_dynamic":   false,
+		"targeting":    map[string]interface{}{"geo_locations": map[string]interface{}{"countries": []string{"US"}}},
+		"promoted_object": struct {
+			PixelID string `json:"pixel_id"`
+		}{"123"},
+		"special_category": []string{"NONE"},
+		"end_time":         nil,
+	})
+	if err != nil {
+		t.Fatalf("SendParams failed: %v", err)
+	}
+
+	if *contentType != "application/x-www-form-urlencoded" {
+		t.Errorf("Expected form content type, got %q", *contentType)
+	}
+	// url.Values encodes keys in sorted order, so the body is exact
+	want := "bid_amount=0&daily_budget=5000&is_dynamic=false&name=Spring+Sale" +
+		"&promoted_object=%7B%22pixel_id%22%3A%22123%22%7D" +
+		"&special_category=%5B%22NONE%22%5D" +
+		"&targeting=%7B%22geo_locations%22%3A%7B%22countries%22%3A%5B%22US%22%5D%7D%7D"
+	if *body != want {
+		t.Errorf("Unexpected form body:\n got: %s\nwant: %s", *body, want)
+	}
+}
+
+func TestSendParams_MultipartWireFormat(t *testing.T) {
+	env := testutil.NewTestEnvironment(t)
+	defer env.Teardown()
+
+	var fields map[string][]string
+	var fileName, fileType, fileContent string
+	env.Server().AddRoute("POST", "/"+DefaultAPIVersion+"/act_123/adimages", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("Expected multipart body: %v", err)
+		}
+		fields = r.MultipartForm.Value
+		file, header, err := r.FormFile("source")
+		if err != nil {
+			t.Fatalf("Expected source file part: %v", err)
+		}
+		defer file.Close()
+		raw, _ := io.ReadAll(file)
+		fileName, fileType, fileContent = header.Filename, header.Header.Get("Content-Type"), string(raw)
+		env.Server().WriteSuccess(w, map[string]interface{}{"images": map[string]interface{}{}})
+	})
+
+	client := NewClient(nil, WithGraphURL(env.Server().URL))
+	_, err := client.SendParams(context.Background(), http.MethodPost, "act_123/adimages", map[string]interface{}{
+		"name":   "banner",
+		"source": &File{Filename: "banner.png", ContentType: "image/png", Content: strings.NewReader("PNGDATA")},
+		"labels": []string{"spring"},
+	})
+	if err != nil {
+		t.Fatalf("SendParams failed: %v", err)
+	}
+
+	if fileName != "banner.png" || fileType != "image/png" || fileContent != "PNGDATA" {
+		t.Errorf("Unexpected file part: %q %q %q", fileName, fileType, fileContent)
+	}
+	if got := fields["name"]; len(got) != 1 || got[0] != "banner" {
+		t.Errorf("Expected name field, got %v", got)
+	}
+	if got := fields["labels"]; len(got) != 1 || got[0] != `["spring"]` {
+		t.Errorf("Expected JSON-encoded labels field, got %v", got)
+	}
+}
+
+func TestSendParams_JSONWireFormat(t *testing.T) {
+	env := testutil.NewTestEnvironment(t)
+	defer env.Teardown()
+
+	contentType, body := captureBody(t, env, "/"+DefaultAPIVersion+"/987/events")
+	client := NewClient(nil, WithGraphURL(env.Server().URL))
+
+	_, err := client.SendParams(context.Background(), http.MethodPost, client.GraphURL("987/events"), map[string]interface{}{
+		"data": []interface{}{map[string]interface{}{"event_name": "Purchase", "event_time": 1700000000}},
+	})
+	if err != nil {
+		t.Fatalf("SendParams failed: %v", err)
+	}
+
+	if *contentType != "application/json" {
+		t.Errorf("Expected JSON content type, got %q", *contentType)
+	}
+	if want := `{"data":[{"event_name":"Purchase","event_time":1700000000}]}`; *body != want {
+		t.Errorf("Unexpected JSON body:\n got: %s\nwant: %s", *body, want)
+	}
+}
+
+func TestBatchRequest_BodyWireFormat(t *testing.T) {
+	req := BatchRequest{
+		Method:      http.MethodPost,
+		RelativeURL: testutil.TestCampaignID,
+		Body: map[string]interface{}{
+			"status":          "PAUSED",
+			"daily_budget":    float64(1000),
+			"promoted_object": map[string]interface{}{"page_id": "1"},
+		},
+	}
+
+	encoded, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	// encoding/json escapes & as \u0026, which the Graph API decodes as usual
+	want := `{"method":"POST","relative_url":"` + testutil.TestCampaignID + `",` +
+		`"body":"daily_budget=1000\u0026promoted_object=%7B%22page_id%22%3A%221%22%7D\u0026status=PAUSED"}`
+	if string(encoded) != want {
+		t.Errorf("Unexpected batch operation:\n got: %s\nwant: %s", encoded, want)
+	}
+
+	var decoded BatchRequest
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if decoded.Body["status"] != "PAUSED" || decoded.Body["promoted_object"] != `{"page_id":"1"}` {
+		t.Errorf("Unexpected decoded body: %v", decoded.Body)
+	}
+}
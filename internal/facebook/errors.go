@@ -0,0 +1,88 @@
+package facebook
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// GraphError is a structured Facebook Graph API error. It is shared by all
+// Facebook packages and returned as structured content in tool results.
+type GraphError struct {
+	Message        string          `json:"message"`
+	Type           string          `json:"type,omitempty"`
+	Code           int             `json:"code"`
+	ErrorSubcode   int             `json:"error_subcode,omitempty"`
+	ErrorUserTitle string          `json:"error_user_title,omitempty"`
+	ErrorUserMsg   string          `json:"error_user_msg,omitempty"`
+	FBTraceID      string          `json:"fbtrace_id,omitempty"`
+	IsTransient    bool            `json:"is_transient,omitempty"`
+	ErrorData      json.RawMessage `json:"error_data,omitempty"`
+	// HTTPStatus is the HTTP status code of the response carrying the error
+	HTTPStatus int `json:"http_status,omitempty"`
+}
+
+// Error implements the error interface
+func (e *GraphError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Facebook API error (code %d", e.Code)
+	if e.ErrorSubcode != 0 {
+		fmt.Fprintf(&b, ", subcode %d", e.ErrorSubcode)
+	}
+	b.WriteString(")")
+	if e.Type != "" {
+		fmt.Fprintf(&b, " %s", e.Type)
+	}
+	fmt.Fprintf(&b, ": %s", e.Message)
+	if e.ErrorUserMsg != "" {
+		if e.ErrorUserTitle != "" {
+			fmt.Fprintf(&b, " - %s: %s", e.ErrorUserTitle, e.ErrorUserMsg)
+		} else {
+			fmt.Fprintf(&b, " - %s", e.ErrorUserMsg)
+		}
+	}
+	if e.FBTraceID != "" {
+		fmt.Fprintf(&b, " (fbtrace_id: %s)", e.FBTraceID)
+	}
+	return b.String()
+}
+
+// Data decodes error_data, which the Graph API sends either as an object or as
+// a JSON-encoded string
+func (e *GraphError) Data() map[string]interface{} {
+	if len(e.ErrorData) == 0 {
+		return nil
+	}
+	raw := []byte(e.ErrorData)
+	var encoded string
+	if err := json.Unmarshal(raw, &encoded); err == nil {
+		raw = []byte(encoded)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil
+	}
+	return data
+}
+
+// ParseGraphError extracts a GraphError from a response body. Batch responses
+// carry the body as a JSON-encoded string, which is also accepted. It returns
+// nil if the body does not contain a Graph API error object.
+func ParseGraphError(httpStatus int, body []byte) *GraphError {
+	var encoded string
+	if err := json.Unmarshal(body, &encoded); err == nil {
+		body = []byte(encoded)
+	}
+
+	var envelope struct {
+		Error *GraphError `json:"error"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil || envelope.Error == nil {
+		return nil
+	}
+	if envelope.Error.Message == "" && envelope.Error.Code == 0 {
+		return nil
+	}
+	envelope.Error.HTTPStatus = httpStatus
+	return envelope.Error
+}
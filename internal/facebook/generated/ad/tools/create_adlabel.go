@@ -18,13 +18,25 @@ type ad_create_adlabelArgs struct {
 	ExecutionOptions []string          `json:"execution_options,omitempty" jsonschema:"description=Execution Options"`
 }
 
+// ad_create_adlabelRules are checked against the raw arguments before calling the Graph API
+var ad_create_adlabelRules = []common.ParamRule{
+	{Name: "adlabels", Type: "array", Required: true},
+	{Name: "api_version", Type: "string", Pattern: "^v[0-9]+\\.[0-9]+$"},
+	{Name: "confirmation_token", Type: "string"},
+	{Name: "dry_run", Type: "boolean"},
+	{Name: "execution_options", Type: "array", Enum: common.AdgroupadlabelsExecutionOptionsEnumParamValues},
+	{Name: "idempotency_key", Type: "string"},
+}
+
 // RegisterAdCreateAdlabelHandler registers the ad_create_adlabel tool
 func RegisterAdCreateAdlabelHandler(s *server.MCPServer) error {
 	tool := mcp.NewToolWithRawSchema(
 		"ad_create_adlabel",
 		"Associate adlabels with this Ad Returns Ad. Required: adlabels",
-		json.RawMessage(`{"additionalProperties":false,"properties":{"adlabels":{"description":"Adlabels","items":{"additionalProperties":true,"type":"object"},"type":"array"},"execution_options":{"description":"Execution Options","items":{"type":"string"},"type":"array"}},"required":["adlabels"],"type":"object"}`),
+		json.RawMessage(`{"additionalProperties":false,"properties":{"adlabels":{"description":"Adlabels","items":{"additionalProperties":true,"type":"object"},"type":"array"},"api_version":{"description":"Graph API version for this call, e.g. \"v23.0\" (defaults to FACEBOOK_API_VERSION)","pattern":"^v[0-9]+\\.[0-9]+$","type":"string"},"confirmation_token":{"description":"Token returned when the server asked for this change to be confirmed. Repeat the call with the same arguments and the token to apply it.","type":"string"},"dry_run":{"description":"Do not apply the change. Where supported the Graph API validates it with execution_options=[\"validate_only\"]; otherwise the request that would be sent is returned.","type":"boolean"},"execution_options":{"description":"Execution Options","items":{"enum":["validate_only"],"type":"string"},"type":"array"},"idempotency_key":{"description":"Unique key for this create, e.g. a UUID. Retrying with the same key and arguments returns the original result instead of creating another object.","maxLength":255,"minLength":1,"type":"string"}},"required":["adlabels"],"type":"object"}`),
 	)
+	tool.RawOutputSchema = json.RawMessage(`{"additionalProperties":true,"properties":{"account_id":{"type":"string"},"ad_active_time":{"type":"string"},"ad_review_feedback":{"type":"object"},"ad_schedule_end_time":{"format":"date-time","type":"string"},"ad_schedule_start_time":{"format":"date-time","type":"string"},"adlabels":{"items":{"type":"object"},"type":"array"},"adset":{"type":"object"},"adset_id":{"type":"string"},"bid_amount":{"type":"integer"},"bid_info":{"type":"object"},"bid_type":{"type":"string"},"campaign":{"type":"object"},"campaign_id":{"type":"string"},"configured_status":{"type":"string"},"conversion_domain":{"type":"string"},"conversion_specs":{"items":{"type":"object"},"type":"array"},"created_time":{"format":"date-time","type":"string"},"creative":{"type":"object"},"creative_asset_groups_spec":{"type":"object"},"demolink_hash":{"type":"string"},"display_sequence":{"type":"integer"},"effective_status":{"type":"string"},"engagement_audience":{"type":"boolean"},"failed_delivery_checks":{"items":{"type":"object"},"type":"array"},"id":{"type":"string"},"issues_info":{"items":{"type":"object"},"type":"array"},"last_updated_by_app_id":{"type":"string"},"name":{"type":"string"},"placement":{"type":"object"},"preview_shareable_link":{"type":"string"},"priority":{"type":"integer"},"recommendations":{"items":{"type":"object"},"type":"array"},"source_ad":{"type":"object"},"source_ad_id":{"type":"string"},"status":{"type":"string"},"targeting":{"type":"object"},"tracking_and_conversion_with_defaults":{"type":"object"},"tracking_specs":{"items":{"type":"object"},"type":"array"},"updated_time":{"format":"date-time","type":"string"}},"type":"object"}`)
+	tool.Annotations = common.CreateAnnotation("Ad: Create Adlabel")
 
 	s.AddTool(tool, AdCreateAdlabelHandler)
 	return nil
@@ -32,6 +44,10 @@ func RegisterAdCreateAdlabelHandler(s *server.MCPServer) error {
 
 // AdCreateAdlabelHandler handles the ad_create_adlabel tool
 func AdCreateAdlabelHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if problems := common.ValidateArguments(request.GetArguments(), ad_create_adlabelRules); len(problems) > 0 {
+		return common.ValidationErrorResult(problems), nil
+	}
+
 	var args ad_create_adlabelArgs
 	if err := request.BindArguments(&args); err != nil {
 		return common.HandleBindError(err)
@@ -39,22 +55,27 @@ func AdCreateAdlabelHandler(ctx context.Context, request mcp.CallToolRequest) (*
 	endpoint := "/adlabels"
 	// Prepare request body
 	body := make(map[string]interface{})
-	if len(args.Adlabels) > 0 {
+	if args.Adlabels != nil {
 		body["adlabels"] = args.Adlabels
 	}
-	if len(args.ExecutionOptions) > 0 {
+	if args.ExecutionOptions != nil {
 		body["execution_options"] = args.ExecutionOptions
 	}
 
+	dryRun := common.IsDryRun(request)
+	if dryRun {
+		body["execution_options"] = common.WithValidateOnly(args.ExecutionOptions)
+	}
+
 	result, err := common.MakeGraphAPIRequest(ctx, "POST", endpoint, nil, body)
 
 	if err != nil {
 		return common.HandleAPIError(err)
 	}
 
-	return &mcp.CallToolResult{
-		Content: []mcp.Content{
-			mcp.NewTextContent(string(result)),
-		},
-	}, nil
+	if dryRun {
+		return common.ValidateOnlyResult(ctx, "POST", endpoint, body, result)
+	}
+
+	return common.GraphResult[common.Ad](result, false)
 }
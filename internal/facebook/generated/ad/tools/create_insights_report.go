@@ -21,23 +21,54 @@ type ad_create_insights_reportArgs struct {
 	ActionReportTime             string                   `json:"action_report_time,omitempty" jsonschema:"description=Action Report Time"`
 	Breakdowns                   []string                 `json:"breakdowns,omitempty" jsonschema:"description=Breakdowns"`
 	DatePreset                   string                   `json:"date_preset,omitempty" jsonschema:"description=Date Preset"`
-	DefaultSummary               bool                     `json:"default_summary,omitempty" jsonschema:"description=Default Summary"`
+	DefaultSummary               *bool                    `json:"default_summary,omitempty" jsonschema:"description=Default Summary"`
 	ExportColumns                []string                 `json:"export_columns,omitempty" jsonschema:"description=Export Columns"`
 	ExportFormat                 string                   `json:"export_format,omitempty" jsonschema:"description=Export Format"`
 	ExportName                   string                   `json:"export_name,omitempty" jsonschema:"description=Export Name"`
 	Fields                       []string                 `json:"fields,omitempty" jsonschema:"description=Fields"`
 	Filtering                    []map[string]interface{} `json:"filtering,omitempty" jsonschema:"description=Filtering"`
 	Level                        string                   `json:"level,omitempty" jsonschema:"description=Level"`
-	Limit                        int                      `json:"limit,omitempty" jsonschema:"description=Limit"`
-	ProductIdLimit               int                      `json:"product_id_limit,omitempty" jsonschema:"description=Product ID Limit,pattern=^[0-9]+$"`
+	Limit                        *int                     `json:"limit,omitempty" jsonschema:"description=Limit"`
+	ProductIdLimit               *int                     `json:"product_id_limit,omitempty" jsonschema:"description=Product ID Limit,pattern=^[0-9]+$"`
 	Sort                         []string                 `json:"sort,omitempty" jsonschema:"description=Sort"`
 	Summary                      []string                 `json:"summary,omitempty" jsonschema:"description=Summary"`
 	SummaryActionBreakdowns      []string                 `json:"summary_action_breakdowns,omitempty" jsonschema:"description=Summary Action Breakdowns"`
 	TimeIncrement                string                   `json:"time_increment,omitempty" jsonschema:"description=Time Increment"`
 	TimeRange                    map[string]interface{}   `json:"time_range,omitempty" jsonschema:"description=Time Range"`
 	TimeRanges                   []map[string]interface{} `json:"time_ranges,omitempty" jsonschema:"description=Time Ranges"`
-	UseAccountAttributionSetting bool                     `json:"use_account_attribution_setting,omitempty" jsonschema:"description=Use Account Attribution Setting"`
-	UseUnifiedAttributionSetting bool                     `json:"use_unified_attribution_setting,omitempty" jsonschema:"description=Use Unified Attribution Setting"`
+	UseAccountAttributionSetting *bool                    `json:"use_account_attribution_setting,omitempty" jsonschema:"description=Use Account Attribution Setting"`
+	UseUnifiedAttributionSetting *bool                    `json:"use_unified_attribution_setting,omitempty" jsonschema:"description=Use Unified Attribution Setting"`
+}
+
+// ad_create_insights_reportRules are checked against the raw arguments before calling the Graph API
+var ad_create_insights_reportRules = []common.ParamRule{
+	{Name: "action_attribution_windows", Type: "array", Enum: common.AdgroupinsightsActionAttributionWindowsEnumParamValues},
+	{Name: "action_breakdowns", Type: "array", Enum: common.AdgroupinsightsActionBreakdownsEnumParamValues},
+	{Name: "action_report_time", Type: "string", Enum: common.AdgroupinsightsActionReportTimeEnumParamValues},
+	{Name: "api_version", Type: "string", Pattern: "^v[0-9]+\\.[0-9]+$"},
+	{Name: "breakdowns", Type: "array", Enum: common.AdgroupinsightsBreakdownsEnumParamValues},
+	{Name: "confirmation_token", Type: "string"},
+	{Name: "date_preset", Type: "string", Enum: common.AdgroupinsightsDatePresetEnumParamValues},
+	{Name: "default_summary", Type: "boolean"},
+	{Name: "dry_run", Type: "boolean"},
+	{Name: "export_columns", Type: "array"},
+	{Name: "export_format", Type: "string"},
+	{Name: "export_name", Type: "string"},
+	{Name: "fields", Type: "array"},
+	{Name: "filtering", Type: "array"},
+	{Name: "id", Type: "string", Required: true, Pattern: "^[0-9]+$"},
+	{Name: "idempotency_key", Type: "string"},
+	{Name: "level", Type: "string", Enum: common.AdgroupinsightsLevelEnumParamValues},
+	{Name: "limit", Type: "integer"},
+	{Name: "product_id_limit", Type: "integer", Pattern: "^[0-9]+$"},
+	{Name: "sort", Type: "array"},
+	{Name: "summary", Type: "array"},
+	{Name: "summary_action_breakdowns", Type: "array", Enum: common.AdgroupinsightsSummaryActionBreakdownsEnumParamValues},
+	{Name: "time_increment", Type: "string"},
+	{Name: "time_range", Type: "object"},
+	{Name: "time_ranges", Type: "array"},
+	{Name: "use_account_attribution_setting", Type: "boolean"},
+	{Name: "use_unified_attribution_setting", Type: "boolean"},
 }
 
 // RegisterAdCreateInsightsReportHandler registers the ad_create_insights_report tool
@@ -45,8 +76,10 @@ func RegisterAdCreateInsightsReportHandler(s *server.MCPServer) error {
 	tool := mcp.NewToolWithRawSchema(
 		"ad_create_insights_report",
 		"Generate an insights report for this Ad Returns AdReportRun.",
-		json.RawMessage(`{"additionalProperties":false,"properties":{"action_attribution_windows":{"description":"Action Attribution Windows","items":{"type":"string"},"type":"array"},"action_breakdowns":{"description":"Action Breakdowns","items":{"type":"string"},"type":"array"},"action_report_time":{"description":"Action Report Time (enum: adgroupinsights_action_report_time_enum_param)","enum":["conversion","impression","lifetime","mixed"],"type":"string"},"breakdowns":{"description":"Breakdowns","items":{"type":"string"},"type":"array"},"date_preset":{"description":"Date Preset (enum: adgroupinsights_date_preset_enum_param)","enum":["data_maximum","last_14d","last_28d","last_30d","last_3d","last_7d","last_90d","last_month","last_quarter","last_week_mon_sun","last_week_sun_sat","last_year","maximum","this_month","this_quarter","this_week_mon_today","this_week_sun_today","this_year","today","yesterday"],"type":"string"},"default_summary":{"description":"Default Summary","type":"boolean"},"export_columns":{"description":"Export Columns","items":{"type":"string"},"type":"array"},"export_format":{"description":"Export Format","type":"string"},"export_name":{"description":"Export Name","type":"string"},"fields":{"description":"Fields","items":{"type":"string"},"type":"array"},"filtering":{"description":"Filtering","items":{"additionalProperties":true,"type":"object"},"type":"array"},"id":{"description":"Ad ID","pattern":"^[0-9]+$","type":"string"},"level":{"description":"Level (enum: adgroupinsights_level_enum_param)","enum":["account","ad","adset","campaign"],"type":"string"},"limit":{"description":"Limit","type":"integer"},"product_id_limit":{"description":"Product ID Limit","pattern":"^[0-9]+$","type":"integer"},"sort":{"description":"Sort","items":{"type":"string"},"type":"array"},"summary":{"description":"Summary","items":{"type":"string"},"type":"array"},"summary_action_breakdowns":{"description":"Summary Action Breakdowns","items":{"type":"string"},"type":"array"},"time_increment":{"description":"Time Increment","type":"string"},"time_range":{"description":"Time Range","type":"string"},"time_ranges":{"description":"Time Ranges","items":{"additionalProperties":true,"type":"object"},"type":"array"},"use_account_attribution_setting":{"description":"Use Account Attribution Setting","type":"boolean"},"use_unified_attribution_setting":{"description":"Use Unified Attribution Setting","type":"boolean"}},"required":["id"],"type":"object"}`),
+		json.RawMessage(`{"additionalProperties":false,"properties":{"action_attribution_windows":{"description":"Action Attribution Windows","items":{"enum":["1d_click","1d_ev","1d_view","28d_click","28d_view","28d_view_all_conversions","28d_view_first_conversion","7d_click","7d_view","7d_view_all_conversions","7d_view_first_conversion","dda","default","skan_click","skan_click_second_postback","skan_click_third_postback","skan_view","skan_view_second_postback","skan_view_third_postback"],"type":"string"},"type":"array"},"action_breakdowns":{"description":"Action Breakdowns","items":{"enum":["action_canvas_component_name","action_carousel_card_id","action_carousel_card_name","action_destination","action_device","action_reaction","action_target_id","action_type","action_video_sound","action_video_type","conversion_destination","matched_persona_id","matched_persona_name","signal_source_bucket","standard_event_content_type"],"type":"string"},"type":"array"},"action_report_time":{"description":"Action Report Time (enum: adgroupinsights_action_report_time_enum_param)","enum":["conversion","impression","lifetime","mixed"],"type":"string"},"api_version":{"description":"Graph API version for this call, e.g. \"v23.0\" (defaults to FACEBOOK_API_VERSION)","pattern":"^v[0-9]+\\.[0-9]+$","type":"string"},"breakdowns":{"description":"Breakdowns","items":{"enum":["ad_extension_domain","ad_extension_url","ad_format_asset","age","app_id","body_asset","breakdown_ad_objective","breakdown_reporting_ad_id","call_to_action_asset","coarse_conversion_value","comscore_market","comscore_market_code","conversion_destination","country","creative_relaxation_asset_type","description_asset","device_platform","dma","fidelity_type","flexible_format_asset_type","frequency_value","gen_ai_asset_type","gender","hourly_stats_aggregated_by_advertiser_time_zone","hourly_stats_aggregated_by_audience_time_zone","hsid","image_asset","impression_device","impression_view_time_advertiser_hour_v2","is_auto_advance","is_conversion_id_modeled","is_rendered_as_delayed_skip_ad","landing_destination","link_url_asset","marketing_messages_btn_name","mdsa_landing_destination","media_asset_url","media_creator","media_destination_url","media_format","media_origin_url","media_text_content","media_type","mmm","place_page_id","platform_position","postback_sequence_index","product_id","publisher_platform","redownload","region","signal_source_bucket","skan_campaign_id","skan_conversion_id","skan_version","sot_attribution_model_type","sot_attribution_window","sot_channel","sot_event_type","sot_source","standard_event_content_type","title_asset","user_persona_id","user_persona_name","video_asset"],"type":"string"},"type":"array"},"confirmation_token":{"description":"Token returned when the server asked for this change to be confirmed. Repeat the call with the same arguments and the token to apply it.","type":"string"},"date_preset":{"description":"Date Preset (enum: adgroupinsights_date_preset_enum_param)","enum":["data_maximum","last_14d","last_28d","last_30d","last_3d","last_7d","last_90d","last_month","last_quarter","last_week_mon_sun","last_week_sun_sat","last_year","maximum","this_month","this_quarter","this_week_mon_today","this_week_sun_today","this_year","today","yesterday"],"type":"string"},"default_summary":{"description":"Default Summary","type":"boolean"},"dry_run":{"description":"Do not apply the change. Where supported the Graph API validates it with execution_options=[\"validate_only\"]; otherwise the request that would be sent is returned.","type":"boolean"},"export_columns":{"description":"Export Columns","items":{"type":"string"},"type":"array"},"export_format":{"description":"Export Format","type":"string"},"export_name":{"description":"Export Name","type":"string"},"fields":{"description":"Fields","items":{"type":"string"},"type":"array"},"filtering":{"description":"Filtering","items":{"additionalProperties":true,"type":"object"},"type":"array"},"id":{"description":"Ad ID","pattern":"^[0-9]+$","type":"string"},"idempotency_key":{"description":"Unique key for this create, e.g. a UUID. Retrying with the same key and arguments returns the original result instead of creating another object.","maxLength":255,"minLength":1,"type":"string"},"level":{"description":"Level (enum: adgroupinsights_level_enum_param)","enum":["account","ad","adset","campaign"],"type":"string"},"limit":{"description":"Limit","type":"integer"},"product_id_limit":{"description":"Product ID Limit","pattern":"^[0-9]+$","type":"integer"},"sort":{"description":"Sort","items":{"type":"string"},"type":"array"},"summary":{"description":"Summary","items":{"type":"string"},"type":"array"},"summary_action_breakdowns":{"description":"Summary Action Breakdowns","items":{"enum":["action_canvas_component_name","action_carousel_card_id","action_carousel_card_name","action_destination","action_device","action_reaction","action_target_id","action_type","action_video_sound","action_video_type","conversion_destination","matched_persona_id","matched_persona_name","signal_source_bucket","standard_event_content_type"],"type":"string"},"type":"array"},"time_increment":{"description":"Time Increment","type":"string"},"time_range":{"additionalProperties":true,"description":"Time Range","type":"object"},"time_ranges":{"description":"Time Ranges","items":{"additionalProperties":true,"type":"object"},"type":"array"},"use_account_attribution_setting":{"description":"Use Account Attribution Setting","type":"boolean"},"use_unified_attribution_setting":{"description":"Use Unified Attribution Setting","type":"boolean"}},"required":["id"],"type":"object"}`),
 	)
+	tool.RawOutputSchema = json.RawMessage(`{"additionalProperties":true,"properties":{"account_id":{"type":"string"},"async_percent_completion":{"type":"integer"},"async_report_url":{"type":"string"},"async_status":{"type":"string"},"date_start":{"type":"string"},"date_stop":{"type":"string"},"emails":{"items":{"type":"string"},"type":"array"},"error_code":{"type":"integer"},"friendly_name":{"type":"string"},"id":{"type":"string"},"is_async_export":{"type":"integer"},"is_bookmarked":{"type":"boolean"},"is_running":{"type":"boolean"},"schedule_id":{"type":"string"},"time_completed":{"type":"integer"},"time_ref":{"type":"integer"}},"type":"object"}`)
+	tool.Annotations = common.CreateAnnotation("Ad: Create Insights Report")
 
 	s.AddTool(tool, AdCreateInsightsReportHandler)
 	return nil
@@ -54,6 +87,10 @@ func RegisterAdCreateInsightsReportHandler(s *server.MCPServer) error {
 
 // AdCreateInsightsReportHandler handles the ad_create_insights_report tool
 func AdCreateInsightsReportHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if problems := common.ValidateArguments(request.GetArguments(), ad_create_insights_reportRules); len(problems) > 0 {
+		return common.ValidationErrorResult(problems), nil
+	}
+
 	var args ad_create_insights_reportArgs
 	if err := request.BindArguments(&args); err != nil {
 		return common.HandleBindError(err)
@@ -61,23 +98,25 @@ func AdCreateInsightsReportHandler(ctx context.Context, request mcp.CallToolRequ
 	endpoint := fmt.Sprintf("/%s/insights", args.ID)
 	// Prepare request body
 	body := make(map[string]interface{})
-	if len(args.ActionAttributionWindows) > 0 {
+	if args.ActionAttributionWindows != nil {
 		body["action_attribution_windows"] = args.ActionAttributionWindows
 	}
-	if len(args.ActionBreakdowns) > 0 {
+	if args.ActionBreakdowns != nil {
 		body["action_breakdowns"] = args.ActionBreakdowns
 	}
 	if args.ActionReportTime != "" {
 		body["action_report_time"] = args.ActionReportTime
 	}
-	if len(args.Breakdowns) > 0 {
+	if args.Breakdowns != nil {
 		body["breakdowns"] = args.Breakdowns
 	}
 	if args.DatePreset != "" {
 		body["date_preset"] = args.DatePreset
 	}
-	body["default_summary"] = args.DefaultSummary
-	if len(args.ExportColumns) > 0 {
+	if args.DefaultSummary != nil {
+		body["default_summary"] = args.DefaultSummary
+	}
+	if args.ExportColumns != nil {
 		body["export_columns"] = args.ExportColumns
 	}
 	if args.ExportFormat != "" {
@@ -86,39 +125,49 @@ func AdCreateInsightsReportHandler(ctx context.Context, request mcp.CallToolRequ
 	if args.ExportName != "" {
 		body["export_name"] = args.ExportName
 	}
-	if len(args.Fields) > 0 {
+	if args.Fields != nil {
 		body["fields"] = args.Fields
 	}
-	if len(args.Filtering) > 0 {
+	if args.Filtering != nil {
 		body["filtering"] = args.Filtering
 	}
 	if args.Level != "" {
 		body["level"] = args.Level
 	}
-	if args.Limit > 0 {
+	if args.Limit != nil {
 		body["limit"] = args.Limit
 	}
-	if args.ProductIdLimit > 0 {
+	if args.ProductIdLimit != nil {
 		body["product_id_limit"] = args.ProductIdLimit
 	}
-	if len(args.Sort) > 0 {
+	if args.Sort != nil {
 		body["sort"] = args.Sort
 	}
-	if len(args.Summary) > 0 {
+	if args.Summary != nil {
 		body["summary"] = args.Summary
 	}
-	if len(args.SummaryActionBreakdowns) > 0 {
+	if args.SummaryActionBreakdowns != nil {
 		body["summary_action_breakdowns"] = args.SummaryActionBreakdowns
 	}
 	if args.TimeIncrement != "" {
 		body["time_increment"] = args.TimeIncrement
 	}
-	body["time_range"] = args.TimeRange
-	if len(args.TimeRanges) > 0 {
+	if args.TimeRange != nil {
+		body["time_range"] = args.TimeRange
+	}
+	if args.TimeRanges != nil {
 		body["time_ranges"] = args.TimeRanges
 	}
-	body["use_account_attribution_setting"] = args.UseAccountAttributionSetting
-	body["use_unified_attribution_setting"] = args.UseUnifiedAttributionSetting
+	if args.UseAccountAttributionSetting != nil {
+		body["use_account_attribution_setting"] = args.UseAccountAttributionSetting
+	}
+	if args.UseUnifiedAttributionSetting != nil {
+		body["use_unified_attribution_setting"] = args.UseUnifiedAttributionSetting
+	}
+
+	if common.IsDryRun(request) {
+		return common.DryRunResult(ctx, "POST", endpoint, nil, body)
+	}
 
 	result, err := common.MakeGraphAPIRequest(ctx, "POST", endpoint, nil, body)
 
@@ -126,9 +175,5 @@ func AdCreateInsightsReportHandler(ctx context.Context, request mcp.CallToolRequ
 		return common.HandleAPIError(err)
 	}
 
-	return &mcp.CallToolResult{
-		Content: []mcp.Content{
-			mcp.NewTextContent(string(result)),
-		},
-	}, nil
+	return common.GraphResult[common.AdReportRun](result, false)
 }
@@ -18,13 +18,23 @@ type ad_deleteArgs struct {
 	ID string `json:"id" jsonschema:"required,description=Ad ID,pattern=^[0-9]+$"`
 }
 
+// ad_deleteRules are checked against the raw arguments before calling the Graph API
+var ad_deleteRules = []common.ParamRule{
+	{Name: "api_version", Type: "string", Pattern: "^v[0-9]+\\.[0-9]+$"},
+	{Name: "confirmation_token", Type: "string"},
+	{Name: "dry_run", Type: "boolean"},
+	{Name: "id", Type: "string", Required: true, Pattern: "^[0-9]+$"},
+}
+
 // RegisterAdDeleteHandler registers the ad_delete tool
 func RegisterAdDeleteHandler(s *server.MCPServer) error {
 	tool := mcp.NewToolWithRawSchema(
 		"ad_delete",
 		"Delete a Ad",
-		json.RawMessage(`{"additionalProperties":false,"properties":{"id":{"description":"Ad ID","pattern":"^[0-9]+$","type":"string"}},"required":["id"],"type":"object"}`),
+		json.RawMessage(`{"additionalProperties":false,"properties":{"api_version":{"description":"Graph API version for this call, e.g. \"v23.0\" (defaults to FACEBOOK_API_VERSION)","pattern":"^v[0-9]+\\.[0-9]+$","type":"string"},"confirmation_token":{"description":"Token returned when the server asked for this change to be confirmed. Repeat the call with the same arguments and the token to apply it.","type":"string"},"dry_run":{"description":"Do not apply the change. Where supported the Graph API validates it with execution_options=[\"validate_only\"]; otherwise the request that would be sent is returned.","type":"boolean"},"id":{"description":"Ad ID","pattern":"^[0-9]+$","type":"string"}},"required":["id"],"type":"object"}`),
 	)
+	tool.RawOutputSchema = json.RawMessage(`{"additionalProperties":true,"type":"object"}`)
+	tool.Annotations = common.DeleteAnnotation("Ad: Delete")
 
 	s.AddTool(tool, AdDeleteHandler)
 	return nil
@@ -32,6 +42,10 @@ func RegisterAdDeleteHandler(s *server.MCPServer) error {
 
 // AdDeleteHandler handles the ad_delete tool
 func AdDeleteHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if problems := common.ValidateArguments(request.GetArguments(), ad_deleteRules); len(problems) > 0 {
+		return common.ValidationErrorResult(problems), nil
+	}
+
 	var args ad_deleteArgs
 	if err := request.BindArguments(&args); err != nil {
 		return common.HandleBindError(err)
@@ -41,15 +55,15 @@ func AdDeleteHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.Cal
 	params := make(map[string]string)
 	// ID is part of path, not query params
 
+	if common.IsDryRun(request) {
+		return common.DryRunResult(ctx, "DELETE", endpoint, params, nil)
+	}
+
 	result, err := common.MakeGraphAPIRequest(ctx, "DELETE", endpoint, params, nil)
 
 	if err != nil {
 		return common.HandleAPIError(err)
 	}
 
-	return &mcp.CallToolResult{
-		Content: []mcp.Content{
-			mcp.NewTextContent(string(result)),
-		},
-	}, nil
+	return common.GraphResult[map[string]interface{}](result, false)
 }
@@ -23,9 +23,26 @@ type ad_getArgs struct {
 	Before                  string                 `json:"before,omitempty" jsonschema:"description=Cursor for pagination (previous page)"`
 	AmCallTags              map[string]interface{} `json:"am_call_tags,omitempty" jsonschema:"description=Am Call Tags"`
 	DatePreset              string                 `json:"date_preset,omitempty" jsonschema:"description=Date Preset"`
-	FromAdtable             bool                   `json:"from_adtable,omitempty" jsonschema:"description=From Adtable"`
-	ReviewFeedbackBreakdown bool                   `json:"review_feedback_breakdown,omitempty" jsonschema:"description=Review Feedback Breakdown"`
+	FromAdtable             *bool                  `json:"from_adtable,omitempty" jsonschema:"description=From Adtable"`
+	ReviewFeedbackBreakdown *bool                  `json:"review_feedback_breakdown,omitempty" jsonschema:"description=Review Feedback Breakdown"`
 	TimeRange               map[string]interface{} `json:"time_range,omitempty" jsonschema:"description=Time Range"`
+	Structured              bool                   `json:"structured,omitempty" jsonschema:"description=Decode the response into typed Ad objects and return structured content"`
+}
+
+// ad_getRules are checked against the raw arguments before calling the Graph API
+var ad_getRules = []common.ParamRule{
+	{Name: "after", Type: "string"},
+	{Name: "am_call_tags", Type: "object"},
+	{Name: "api_version", Type: "string", Pattern: "^v[0-9]+\\.[0-9]+$"},
+	{Name: "before", Type: "string"},
+	{Name: "date_preset", Type: "string", Enum: common.AdgroupDatePresetValues},
+	{Name: "fields", Type: "array"},
+	{Name: "from_adtable", Type: "boolean"},
+	{Name: "id", Type: "string", Required: true, Pattern: "^[0-9]+$"},
+	{Name: "limit", Type: "integer", Minimum: common.Bound(1), Maximum: common.Bound(100)},
+	{Name: "review_feedback_breakdown", Type: "boolean"},
+	{Name: "structured", Type: "boolean"},
+	{Name: "time_range", Type: "object"},
 }
 
 // RegisterAdGetHandler registers the ad_get tool
@@ -33,8 +50,10 @@ func RegisterAdGetHandler(s *server.MCPServer) error {
 	tool := mcp.NewToolWithRawSchema(
 		"ad_get",
 		"Get details of a specific Ad Returns Ad.",
-		json.RawMessage(`{"additionalProperties":false,"properties":{"after":{"description":"Cursor for pagination (next page)","type":"string"},"am_call_tags":{"description":"Am Call Tags","type":"string"},"before":{"description":"Cursor for pagination (previous page)","type":"string"},"date_preset":{"description":"Date Preset (enum: adgroup_date_preset)","enum":["data_maximum","last_14d","last_28d","last_30d","last_3d","last_7d","last_90d","last_month","last_quarter","last_week_mon_sun","last_week_sun_sat","last_year","maximum","this_month","this_quarter","this_week_mon_today","this_week_sun_today","this_year","today","yesterday"],"type":"string"},"fields":{"description":"Fields to return","items":{"type":"string"},"type":"array"},"from_adtable":{"description":"From Adtable","type":"boolean"},"id":{"description":"Ad ID","pattern":"^[0-9]+$","type":"string"},"limit":{"description":"Maximum number of results","maximum":100,"minimum":1,"type":"integer"},"review_feedback_breakdown":{"description":"Review Feedback Breakdown","type":"boolean"},"time_range":{"description":"Time Range","type":"string"}},"required":["id"],"type":"object"}`),
+		json.RawMessage(`{"additionalProperties":false,"properties":{"after":{"description":"Cursor for pagination (next page)","type":"string"},"am_call_tags":{"additionalProperties":true,"description":"Am Call Tags","type":"object"},"api_version":{"description":"Graph API version for this call, e.g. \"v23.0\" (defaults to FACEBOOK_API_VERSION)","pattern":"^v[0-9]+\\.[0-9]+$","type":"string"},"before":{"description":"Cursor for pagination (previous page)","type":"string"},"date_preset":{"description":"Date Preset (enum: adgroup_date_preset)","enum":["data_maximum","last_14d","last_28d","last_30d","last_3d","last_7d","last_90d","last_month","last_quarter","last_week_mon_sun","last_week_sun_sat","last_year","maximum","this_month","this_quarter","this_week_mon_today","this_week_sun_today","this_year","today","yesterday"],"type":"string"},"fields":{"description":"Fields to return","items":{"type":"string"},"type":"array"},"from_adtable":{"description":"From Adtable","type":"boolean"},"id":{"description":"Ad ID","pattern":"^[0-9]+$","type":"string"},"limit":{"description":"Maximum number of results","maximum":100,"minimum":1,"type":"integer"},"review_feedback_breakdown":{"description":"Review Feedback Breakdown","type":"boolean"},"structured":{"description":"Decode the response into typed Ad objects, with RFC 3339 UTC timestamps, and return it as structured content","type":"boolean"},"time_range":{"additionalProperties":true,"description":"Time Range","type":"object"}},"required":["id"],"type":"object"}`),
 	)
+	tool.RawOutputSchema = json.RawMessage(`{"additionalProperties":true,"properties":{"account_id":{"type":"string"},"ad_active_time":{"type":"string"},"ad_review_feedback":{"type":"object"},"ad_schedule_end_time":{"format":"date-time","type":"string"},"ad_schedule_start_time":{"format":"date-time","type":"string"},"adlabels":{"items":{"type":"object"},"type":"array"},"adset":{"type":"object"},"adset_id":{"type":"string"},"bid_amount":{"type":"integer"},"bid_info":{"type":"object"},"bid_type":{"type":"string"},"campaign":{"type":"object"},"campaign_id":{"type":"string"},"configured_status":{"type":"string"},"conversion_domain":{"type":"string"},"conversion_specs":{"items":{"type":"object"},"type":"array"},"created_time":{"format":"date-time","type":"string"},"creative":{"type":"object"},"creative_asset_groups_spec":{"type":"object"},"demolink_hash":{"type":"string"},"display_sequence":{"type":"integer"},"effective_status":{"type":"string"},"engagement_audience":{"type":"boolean"},"failed_delivery_checks":{"items":{"type":"object"},"type":"array"},"id":{"type":"string"},"issues_info":{"items":{"type":"object"},"type":"array"},"last_updated_by_app_id":{"type":"string"},"name":{"type":"string"},"placement":{"type":"object"},"preview_shareable_link":{"type":"string"},"priority":{"type":"integer"},"recommendations":{"items":{"type":"object"},"type":"array"},"source_ad":{"type":"object"},"source_ad_id":{"type":"string"},"status":{"type":"string"},"targeting":{"type":"object"},"tracking_and_conversion_with_defaults":{"type":"object"},"tracking_specs":{"items":{"type":"object"},"type":"array"},"updated_time":{"format":"date-time","type":"string"}},"type":"object"}`)
+	tool.Annotations = common.ReadOnlyAnnotation("Ad: Get")
 
 	s.AddTool(tool, AdGetHandler)
 	return nil
@@ -42,6 +61,10 @@ func RegisterAdGetHandler(s *server.MCPServer) error {
 
 // AdGetHandler handles the ad_get tool
 func AdGetHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if problems := common.ValidateArguments(request.GetArguments(), ad_getRules); len(problems) > 0 {
+		return common.ValidationErrorResult(problems), nil
+	}
+
 	var args ad_getArgs
 	if err := request.BindArguments(&args); err != nil {
 		return common.HandleBindError(err)
@@ -62,13 +85,21 @@ func AdGetHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallTo
 	if args.Before != "" {
 		params["before"] = args.Before
 	}
-	// TODO: Handle map[string]interface{} for AmCallTags
+	if err := common.SetJSONParam(params, "am_call_tags", args.AmCallTags); err != nil {
+		return common.HandleBindError(err)
+	}
 	if args.DatePreset != "" {
 		params["date_preset"] = args.DatePreset
 	}
-	params["from_adtable"] = fmt.Sprintf("%v", args.FromAdtable)
-	params["review_feedback_breakdown"] = fmt.Sprintf("%v", args.ReviewFeedbackBreakdown)
-	// TODO: Handle map[string]interface{} for TimeRange
+	if args.FromAdtable != nil {
+		params["from_adtable"] = fmt.Sprintf("%v", *args.FromAdtable)
+	}
+	if args.ReviewFeedbackBreakdown != nil {
+		params["review_feedback_breakdown"] = fmt.Sprintf("%v", *args.ReviewFeedbackBreakdown)
+	}
+	if err := common.SetJSONParam(params, "time_range", args.TimeRange); err != nil {
+		return common.HandleBindError(err)
+	}
 
 	result, err := common.MakeGraphAPIRequest(ctx, "GET", endpoint, params, nil)
 
@@ -76,9 +107,9 @@ func AdGetHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallTo
 		return common.HandleAPIError(err)
 	}
 
-	return &mcp.CallToolResult{
-		Content: []mcp.Content{
-			mcp.NewTextContent(string(result)),
-		},
-	}, nil
+	if args.Structured {
+		return common.StructuredObjectResult[common.Ad](result)
+	}
+
+	return common.GraphResult[common.Ad](result, false)
 }
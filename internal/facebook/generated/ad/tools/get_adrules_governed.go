@@ -20,7 +20,19 @@ type ad_get_adrules_governedArgs struct {
 	Limit          int      `json:"limit,omitempty" jsonschema:"description=Maximum number of results,minimum=1,maximum=100"`
 	After          string   `json:"after,omitempty" jsonschema:"description=Cursor for pagination (next page)"`
 	Before         string   `json:"before,omitempty" jsonschema:"description=Cursor for pagination (previous page)"`
-	PassEvaluation bool     `json:"pass_evaluation,omitempty" jsonschema:"description=Pass Evaluation"`
+	PassEvaluation *bool    `json:"pass_evaluation,omitempty" jsonschema:"description=Pass Evaluation"`
+	Structured     bool     `json:"structured,omitempty" jsonschema:"description=Decode the response into typed AdRule objects and return structured content"`
+}
+
+// ad_get_adrules_governedRules are checked against the raw arguments before calling the Graph API
+var ad_get_adrules_governedRules = []common.ParamRule{
+	{Name: "after", Type: "string"},
+	{Name: "api_version", Type: "string", Pattern: "^v[0-9]+\\.[0-9]+$"},
+	{Name: "before", Type: "string"},
+	{Name: "fields", Type: "array"},
+	{Name: "limit", Type: "integer", Minimum: common.Bound(1), Maximum: common.Bound(100)},
+	{Name: "pass_evaluation", Type: "boolean"},
+	{Name: "structured", Type: "boolean"},
 }
 
 // RegisterAdGetAdrulesGovernedHandler registers the ad_get_adrules_governed tool
@@ -28,8 +40,10 @@ func RegisterAdGetAdrulesGovernedHandler(s *server.MCPServer) error {
 	tool := mcp.NewToolWithRawSchema(
 		"ad_get_adrules_governed",
 		"Get adrules_governed data for this Ad Returns AdRule.",
-		json.RawMessage(`{"additionalProperties":false,"properties":{"after":{"description":"Cursor for pagination (next page)","type":"string"},"before":{"description":"Cursor for pagination (previous page)","type":"string"},"fields":{"description":"Fields to return","items":{"type":"string"},"type":"array"},"limit":{"description":"Maximum number of results","maximum":100,"minimum":1,"type":"integer"},"pass_evaluation":{"description":"Pass Evaluation","type":"boolean"}},"required":[],"type":"object"}`),
+		json.RawMessage(`{"additionalProperties":false,"properties":{"after":{"description":"Cursor for pagination (next page)","type":"string"},"api_version":{"description":"Graph API version for this call, e.g. \"v23.0\" (defaults to FACEBOOK_API_VERSION)","pattern":"^v[0-9]+\\.[0-9]+$","type":"string"},"before":{"description":"Cursor for pagination (previous page)","type":"string"},"fields":{"description":"Fields to return","items":{"type":"string"},"type":"array"},"limit":{"description":"Maximum number of results","maximum":100,"minimum":1,"type":"integer"},"pass_evaluation":{"description":"Pass Evaluation","type":"boolean"},"structured":{"description":"Decode the response into typed AdRule objects, with RFC 3339 UTC timestamps, and return it as structured content","type":"boolean"}},"required":[],"type":"object"}`),
 	)
+	tool.RawOutputSchema = json.RawMessage(`{"additionalProperties":true,"properties":{"data":{"items":{"additionalProperties":true,"properties":{"account_id":{"type":"string"},"created_by":{"type":"object"},"created_time":{"format":"date-time","type":"string"},"disable_error_code":{"type":"integer"},"evaluation_spec":{"type":"object"},"execution_spec":{"type":"object"},"id":{"type":"string"},"name":{"type":"string"},"schedule_spec":{"type":"object"},"status":{"type":"string"},"updated_time":{"format":"date-time","type":"string"}},"type":"object"},"type":"array"},"pagination":{"type":"object"},"paging":{"type":"object"},"summary":{"type":"object"}},"type":"object"}`)
+	tool.Annotations = common.ReadOnlyAnnotation("Ad: Get Adrules Governed")
 
 	s.AddTool(tool, AdGetAdrulesGovernedHandler)
 	return nil
@@ -37,6 +51,10 @@ func RegisterAdGetAdrulesGovernedHandler(s *server.MCPServer) error {
 
 // AdGetAdrulesGovernedHandler handles the ad_get_adrules_governed tool
 func AdGetAdrulesGovernedHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if problems := common.ValidateArguments(request.GetArguments(), ad_get_adrules_governedRules); len(problems) > 0 {
+		return common.ValidationErrorResult(problems), nil
+	}
+
 	var args ad_get_adrules_governedArgs
 	if err := request.BindArguments(&args); err != nil {
 		return common.HandleBindError(err)
@@ -56,7 +74,9 @@ func AdGetAdrulesGovernedHandler(ctx context.Context, request mcp.CallToolReques
 	if args.Before != "" {
 		params["before"] = args.Before
 	}
-	params["pass_evaluation"] = fmt.Sprintf("%v", args.PassEvaluation)
+	if args.PassEvaluation != nil {
+		params["pass_evaluation"] = fmt.Sprintf("%v", *args.PassEvaluation)
+	}
 
 	result, err := common.MakeGraphAPIRequest(ctx, "GET", endpoint, params, nil)
 
@@ -64,9 +84,9 @@ func AdGetAdrulesGovernedHandler(ctx context.Context, request mcp.CallToolReques
 		return common.HandleAPIError(err)
 	}
 
-	return &mcp.CallToolResult{
-		Content: []mcp.Content{
-			mcp.NewTextContent(string(result)),
-		},
-	}, nil
+	if args.Structured {
+		return common.StructuredListResult[common.AdRule](result)
+	}
+
+	return common.GraphResult[common.AdRule](result, true)
 }
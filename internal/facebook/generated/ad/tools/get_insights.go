@@ -26,21 +26,53 @@ type ad_get_insightsArgs struct {
 	ActionReportTime             string                   `json:"action_report_time,omitempty" jsonschema:"description=Action Report Time"`
 	Breakdowns                   []string                 `json:"breakdowns,omitempty" jsonschema:"description=Breakdowns"`
 	DatePreset                   string                   `json:"date_preset,omitempty" jsonschema:"description=Date Preset"`
-	DefaultSummary               bool                     `json:"default_summary,omitempty" jsonschema:"description=Default Summary"`
+	DefaultSummary               *bool                    `json:"default_summary,omitempty" jsonschema:"description=Default Summary"`
 	ExportColumns                []string                 `json:"export_columns,omitempty" jsonschema:"description=Export Columns"`
 	ExportFormat                 string                   `json:"export_format,omitempty" jsonschema:"description=Export Format"`
 	ExportName                   string                   `json:"export_name,omitempty" jsonschema:"description=Export Name"`
 	Filtering                    []map[string]interface{} `json:"filtering,omitempty" jsonschema:"description=Filtering"`
 	Level                        string                   `json:"level,omitempty" jsonschema:"description=Level"`
-	ProductIdLimit               int                      `json:"product_id_limit,omitempty" jsonschema:"description=Product ID Limit,pattern=^[0-9]+$"`
+	ProductIdLimit               *int                     `json:"product_id_limit,omitempty" jsonschema:"description=Product ID Limit,pattern=^[0-9]+$"`
 	Sort                         []string                 `json:"sort,omitempty" jsonschema:"description=Sort"`
 	Summary                      []string                 `json:"summary,omitempty" jsonschema:"description=Summary"`
 	SummaryActionBreakdowns      []string                 `json:"summary_action_breakdowns,omitempty" jsonschema:"description=Summary Action Breakdowns"`
 	TimeIncrement                string                   `json:"time_increment,omitempty" jsonschema:"description=Time Increment"`
 	TimeRange                    map[string]interface{}   `json:"time_range,omitempty" jsonschema:"description=Time Range"`
 	TimeRanges                   []map[string]interface{} `json:"time_ranges,omitempty" jsonschema:"description=Time Ranges"`
-	UseAccountAttributionSetting bool                     `json:"use_account_attribution_setting,omitempty" jsonschema:"description=Use Account Attribution Setting"`
-	UseUnifiedAttributionSetting bool                     `json:"use_unified_attribution_setting,omitempty" jsonschema:"description=Use Unified Attribution Setting"`
+	UseAccountAttributionSetting *bool                    `json:"use_account_attribution_setting,omitempty" jsonschema:"description=Use Account Attribution Setting"`
+	UseUnifiedAttributionSetting *bool                    `json:"use_unified_attribution_setting,omitempty" jsonschema:"description=Use Unified Attribution Setting"`
+	Structured                   bool                     `json:"structured,omitempty" jsonschema:"description=Decode the response into typed AdsInsights objects and return structured content"`
+}
+
+// ad_get_insightsRules are checked against the raw arguments before calling the Graph API
+var ad_get_insightsRules = []common.ParamRule{
+	{Name: "action_attribution_windows", Type: "array", Enum: common.AdgroupinsightsActionAttributionWindowsEnumParamValues},
+	{Name: "action_breakdowns", Type: "array", Enum: common.AdgroupinsightsActionBreakdownsEnumParamValues},
+	{Name: "action_report_time", Type: "string", Enum: common.AdgroupinsightsActionReportTimeEnumParamValues},
+	{Name: "after", Type: "string"},
+	{Name: "api_version", Type: "string", Pattern: "^v[0-9]+\\.[0-9]+$"},
+	{Name: "before", Type: "string"},
+	{Name: "breakdowns", Type: "array", Enum: common.AdgroupinsightsBreakdownsEnumParamValues},
+	{Name: "date_preset", Type: "string", Enum: common.AdgroupinsightsDatePresetEnumParamValues},
+	{Name: "default_summary", Type: "boolean"},
+	{Name: "export_columns", Type: "array"},
+	{Name: "export_format", Type: "string"},
+	{Name: "export_name", Type: "string"},
+	{Name: "fields", Type: "array"},
+	{Name: "filtering", Type: "array"},
+	{Name: "id", Type: "string", Required: true, Pattern: "^[0-9]+$"},
+	{Name: "level", Type: "string", Enum: common.AdgroupinsightsLevelEnumParamValues},
+	{Name: "limit", Type: "integer", Minimum: common.Bound(1), Maximum: common.Bound(100)},
+	{Name: "product_id_limit", Type: "integer", Pattern: "^[0-9]+$"},
+	{Name: "sort", Type: "array"},
+	{Name: "structured", Type: "boolean"},
+	{Name: "summary", Type: "array"},
+	{Name: "summary_action_breakdowns", Type: "array", Enum: common.AdgroupinsightsSummaryActionBreakdownsEnumParamValues},
+	{Name: "time_increment", Type: "string"},
+	{Name: "time_range", Type: "object"},
+	{Name: "time_ranges", Type: "array"},
+	{Name: "use_account_attribution_setting", Type: "boolean"},
+	{Name: "use_unified_attribution_setting", Type: "boolean"},
 }
 
 // RegisterAdGetInsightsHandler registers the ad_get_insights tool
@@ -48,8 +80,10 @@ func RegisterAdGetInsightsHandler(s *server.MCPServer) error {
 	tool := mcp.NewToolWithRawSchema(
 		"ad_get_insights",
 		"List insights for this Ad Returns AdsInsights.",
-		json.RawMessage(`{"additionalProperties":false,"properties":{"action_attribution_windows":{"description":"Action Attribution Windows","items":{"type":"string"},"type":"array"},"action_breakdowns":{"description":"Action Breakdowns","items":{"type":"string"},"type":"array"},"action_report_time":{"description":"Action Report Time (enum: adgroupinsights_action_report_time_enum_param)","enum":["conversion","impression","lifetime","mixed"],"type":"string"},"after":{"description":"Cursor for pagination (next page)","type":"string"},"before":{"description":"Cursor for pagination (previous page)","type":"string"},"breakdowns":{"description":"Breakdowns","items":{"type":"string"},"type":"array"},"date_preset":{"description":"Date Preset (enum: adgroupinsights_date_preset_enum_param)","enum":["data_maximum","last_14d","last_28d","last_30d","last_3d","last_7d","last_90d","last_month","last_quarter","last_week_mon_sun","last_week_sun_sat","last_year","maximum","this_month","this_quarter","this_week_mon_today","this_week_sun_today","this_year","today","yesterday"],"type":"string"},"default_summary":{"description":"Default Summary","type":"boolean"},"export_columns":{"description":"Export Columns","items":{"type":"string"},"type":"array"},"export_format":{"description":"Export Format","type":"string"},"export_name":{"description":"Export Name","type":"string"},"fields":{"description":"Fields to return","items":{"type":"string"},"type":"array"},"filtering":{"description":"Filtering","items":{"additionalProperties":true,"type":"object"},"type":"array"},"id":{"description":"Ad ID","pattern":"^[0-9]+$","type":"string"},"level":{"description":"Level (enum: adgroupinsights_level_enum_param)","enum":["account","ad","adset","campaign"],"type":"string"},"limit":{"description":"Maximum number of results","maximum":100,"minimum":1,"type":"integer"},"product_id_limit":{"description":"Product ID Limit","pattern":"^[0-9]+$","type":"integer"},"sort":{"description":"Sort","items":{"type":"string"},"type":"array"},"summary":{"description":"Summary","items":{"type":"string"},"type":"array"},"summary_action_breakdowns":{"description":"Summary Action Breakdowns","items":{"type":"string"},"type":"array"},"time_increment":{"description":"Time Increment","type":"string"},"time_range":{"description":"Time Range","type":"string"},"time_ranges":{"description":"Time Ranges","items":{"additionalProperties":true,"type":"object"},"type":"array"},"use_account_attribution_setting":{"description":"Use Account Attribution Setting","type":"boolean"},"use_unified_attribution_setting":{"description":"Use Unified Attribution Setting","type":"boolean"}},"required":["id"],"type":"object"}`),
+		json.RawMessage(`{"additionalProperties":false,"properties":{"action_attribution_windows":{"description":"Action Attribution Windows","items":{"enum":["1d_click","1d_ev","1d_view","28d_click","28d_view","28d_view_all_conversions","28d_view_first_conversion","7d_click","7d_view","7d_view_all_conversions","7d_view_first_conversion","dda","default","skan_click","skan_click_second_postback","skan_click_third_postback","skan_view","skan_view_second_postback","skan_view_third_postback"],"type":"string"},"type":"array"},"action_breakdowns":{"description":"Action Breakdowns","items":{"enum":["action_canvas_component_name","action_carousel_card_id","action_carousel_card_name","action_destination","action_device","action_reaction","action_target_id","action_type","action_video_sound","action_video_type","conversion_destination","matched_persona_id","matched_persona_name","signal_source_bucket","standard_event_content_type"],"type":"string"},"type":"array"},"action_report_time":{"description":"Action Report Time (enum: adgroupinsights_action_report_time_enum_param)","enum":["conversion","impression","lifetime","mixed"],"type":"string"},"after":{"description":"Cursor for pagination (next page)","type":"string"},"api_version":{"description":"Graph API version for this call, e.g. \"v23.0\" (defaults to FACEBOOK_API_VERSION)","pattern":"^v[0-9]+\\.[0-9]+$","type":"string"},"before":{"description":"Cursor for pagination (previous page)","type":"string"},"breakdowns":{"description":"Breakdowns","items":{"enum":["ad_extension_domain","ad_extension_url","ad_format_asset","age","app_id","body_asset","breakdown_ad_objective","breakdown_reporting_ad_id","call_to_action_asset","coarse_conversion_value","comscore_market","comscore_market_code","conversion_destination","country","creative_relaxation_asset_type","description_asset","device_platform","dma","fidelity_type","flexible_format_asset_type","frequency_value","gen_ai_asset_type","gender","hourly_stats_aggregated_by_advertiser_time_zone","hourly_stats_aggregated_by_audience_time_zone","hsid","image_asset","impression_device","impression_view_time_advertiser_hour_v2","is_auto_advance","is_conversion_id_modeled","is_rendered_as_delayed_skip_ad","landing_destination","link_url_asset","marketing_messages_btn_name","mdsa_landing_destination","media_asset_url","media_creator","media_destination_url","media_format","media_origin_url","media_text_content","media_type","mmm","place_page_id","platform_position","postback_sequence_index","product_id","publisher_platform","redownload","region","signal_source_bucket","skan_campaign_id","skan_conversion_id","skan_version","sot_attribution_model_type","sot_attribution_window","sot_channel","sot_event_type","sot_source","standard_event_content_type","title_asset","user_persona_id","user_persona_name","video_asset"],"type":"string"},"type":"array"},"date_preset":{"description":"Date Preset (enum: adgroupinsights_date_preset_enum_param)","enum":["data_maximum","last_14d","last_28d","last_30d","last_3d","last_7d","last_90d","last_month","last_quarter","last_week_mon_sun","last_week_sun_sat","last_year","maximum","this_month","this_quarter","this_week_mon_today","this_week_sun_today","this_year","today","yesterday"],"type":"string"},"default_summary":{"description":"Default Summary","type":"boolean"},"export_columns":{"description":"Export Columns","items":{"type":"string"},"type":"array"},"export_format":{"description":"Export Format","type":"string"},"export_name":{"description":"Export Name","type":"string"},"fields":{"description":"Fields to return","items":{"type":"string"},"type":"array"},"filtering":{"description":"Filtering","items":{"additionalProperties":true,"type":"object"},"type":"array"},"id":{"description":"Ad ID","pattern":"^[0-9]+$","type":"string"},"level":{"description":"Level (enum: adgroupinsights_level_enum_param)","enum":["account","ad","adset","campaign"],"type":"string"},"limit":{"description":"Maximum number of results","maximum":100,"minimum":1,"type":"integer"},"product_id_limit":{"description":"Product ID Limit","pattern":"^[0-9]+$","type":"integer"},"sort":{"description":"Sort","items":{"type":"string"},"type":"array"},"structured":{"description":"Decode the response into typed AdsInsights objects, with RFC 3339 UTC timestamps, and return it as structured content","type":"boolean"},"summary":{"description":"Summary","items":{"type":"string"},"type":"array"},"summary_action_breakdowns":{"description":"Summary Action Breakdowns","items":{"enum":["action_canvas_component_name","action_carousel_card_id","action_carousel_card_name","action_destination","action_device","action_reaction","action_target_id","action_type","action_video_sound","action_video_type","conversion_destination","matched_persona_id","matched_persona_name","signal_source_bucket","standard_event_content_type"],"type":"string"},"type":"array"},"time_increment":{"description":"Time Increment","type":"string"},"time_range":{"additionalProperties":true,"description":"Time Range","type":"object"},"time_ranges":{"description":"Time Ranges","items":{"additionalProperties":true,"type":"object"},"type":"array"},"use_account_attribution_setting":{"description":"Use Account Attribution Setting","type":"boolean"},"use_unified_attribution_setting":{"description":"Use Unified Attribution Setting","type":"boolean"}},"required":["id"],"type":"object"}`),
 	)
+	tool.RawOutputSchema = json.RawMessage(`{"additionalProperties":true,"properties":{"data":{"items":{"additionalProperties":true,"properties":{"account_currency":{"type":"string"},"account_id":{"type":"string"},"account_name":{"type":"string"},"action_values":{"items":{"type":"object"},"type":"array"},"actions":{"items":{"type":"object"},"type":"array"},"ad_click_actions":{"items":{"type":"object"},"type":"array"},"ad_id":{"type":"string"},"ad_impression_actions":{"items":{"type":"object"},"type":"array"},"ad_name":{"type":"string"},"adset_end":{"type":"string"},"adset_id":{"type":"string"},"adset_name":{"type":"string"},"adset_start":{"type":"string"},"age_targeting":{"type":"string"},"attribution_setting":{"type":"string"},"auction_bid":{"type":"string"},"auction_competitiveness":{"type":"string"},"auction_max_competitor_bid":{"type":"string"},"average_purchases_conversion_value":{"items":{"type":"object"},"type":"array"},"buying_type":{"type":"string"},"campaign_id":{"type":"string"},"campaign_name":{"type":"string"},"canvas_avg_view_percent":{"type":"string"},"canvas_avg_view_time":{"type":"string"},"catalog_segment_actions":{"items":{"type":"object"},"type":"array"},"catalog_segment_value":{"items":{"type":"object"},"type":"array"},"catalog_segment_value_mobile_purchase_roas":{"items":{"type":"object"},"type":"array"},"catalog_segment_value_omni_purchase_roas":{"items":{"type":"object"},"type":"array"},"catalog_segment_value_website_purchase_roas":{"items":{"type":"object"},"type":"array"},"clicks":{"type":"string"},"conversion_lead_rate":{"items":{"type":"object"},"type":"array"},"conversion_leads":{"items":{"type":"object"},"type":"array"},"conversion_rate_ranking":{"type":"string"},"conversion_values":{"items":{"type":"object"},"type":"array"},"conversions":{"items":{"type":"object"},"type":"array"},"converted_product_app_custom_event_fb_mobile_purchase":{"items":{"type":"object"},"type":"array"},"converted_product_app_custom_event_fb_mobile_purchase_value":{"items":{"type":"object"},"type":"array"},"converted_product_offline_purchase":{"items":{"type":"object"},"type":"array"},"converted_product_offline_purchase_value":{"items":{"type":"object"},"type":"array"},"converted_product_omni_purchase":{"items":{"type":"object"},"type":"array"},"converted_product_omni_purchase_values":{"items":{"type":"object"},"type":"array"},"converted_product_quantity":{"items":{"type":"object"},"type":"array"},"converted_product_value":{"items":{"type":"object"},"type":"array"},"converted_product_website_pixel_purchase":{"items":{"type":"object"},"type":"array"},"converted_product_website_pixel_purchase_value":{"items":{"type":"object"},"type":"array"},"converted_promoted_product_app_custom_event_fb_mobile_purchase":{"items":{"type":"object"},"type":"array"},"converted_promoted_product_app_custom_event_fb_mobile_purchase_value":{"items":{"type":"object"},"type":"array"},"converted_promoted_product_offline_purchase":{"items":{"type":"object"},"type":"array"},"converted_promoted_product_offline_purchase_value":{"items":{"type":"object"},"type":"array"},"converted_promoted_product_omni_purchase":{"items":{"type":"object"},"type":"array"},"converted_promoted_product_omni_purchase_values":{"items":{"type":"object"},"type":"array"},"converted_promoted_product_quantity":{"items":{"type":"object"},"type":"array"},"converted_promoted_product_value":{"items":{"type":"object"},"type":"array"},"converted_promoted_product_website_pixel_purchase":{"items":{"type":"object"},"type":"array"},"converted_promoted_product_website_pixel_purchase_value":{"items":{"type":"object"},"type":"array"},"cost_per_15_sec_video_view":{"items":{"type":"object"},"type":"array"},"cost_per_2_sec_continuous_video_view":{"items":{"type":"object"},"type":"array"},"cost_per_action_type":{"items":{"type":"object"},"type":"array"},"cost_per_ad_click":{"items":{"type":"object"},"type":"array"},"cost_per_conversion":{"items":{"type":"object"},"type":"array"},"cost_per_conversion_lead":{"items":{"type":"object"},"type":"array"},"cost_per_dda_countby_convs":{"type":"string"},"cost_per_estimated_ad_recallers":{"type":"string"},"cost_per_inline_link_click":{"type":"string"},"cost_per_inline_post_engagement":{"type":"string"},"cost_per_objective_result":{"items":{"type":"object"},"type":"array"},"cost_per_one_thousand_ad_impression":{"items":{"type":"object"},"type":"array"},"cost_per_outbound_click":{"items":{"type":"object"},"type":"array"},"cost_per_result":{"items":{"type":"object"},"type":"array"},"cost_per_thruplay":{"items":{"type":"object"},"type":"array"},"cost_per_unique_action_type":{"items":{"type":"object"},"type":"array"},"cost_per_unique_click":{"type":"string"},"cost_per_unique_conversion":{"items":{"type":"object"},"type":"array"},"cost_per_unique_inline_link_click":{"type":"string"},"cost_per_unique_outbound_click":{"items":{"type":"object"},"type":"array"},"cpc":{"type":"string"},"cpm":{"type":"string"},"cpp":{"type":"string"},"created_time":{"type":"string"},"creative_media_type":{"type":"string"},"ctr":{"type":"string"},"date_start":{"type":"string"},"date_stop":{"type":"string"},"dda_countby_convs":{"type":"string"},"dda_results":{"items":{"type":"object"},"type":"array"},"engagement_rate_ranking":{"type":"string"},"estimated_ad_recall_rate":{"type":"string"},"estimated_ad_recall_rate_lower_bound":{"type":"string"},"estimated_ad_recall_rate_upper_bound":{"type":"string"},"estimated_ad_recallers":{"type":"string"},"estimated_ad_recallers_lower_bound":{"type":"string"},"estimated_ad_recallers_upper_bound":{"type":"string"},"frequency":{"type":"string"},"full_view_impressions":{"type":"string"},"full_view_reach":{"type":"string"},"gender_targeting":{"type":"string"},"impressions":{"type":"string"},"inline_link_click_ctr":{"type":"string"},"inline_link_clicks":{"type":"string"},"inline_post_engagement":{"type":"string"},"instagram_upcoming_event_reminders_set":{"type":"string"},"instant_experience_clicks_to_open":{"type":"string"},"instant_experience_clicks_to_start":{"type":"string"},"instant_experience_outbound_clicks":{"items":{"type":"object"},"type":"array"},"interactive_component_tap":{"items":{"type":"object"},"type":"array"},"labels":{"type":"string"},"landing_page_view_actions_per_link_click":{"type":"string"},"landing_page_view_per_link_click":{"type":"string"},"landing_page_view_per_purchase_rate":{"type":"string"},"location":{"type":"string"},"marketing_messages_click_rate_benchmark":{"type":"string"},"marketing_messages_cost_per_delivered":{"type":"string"},"marketing_messages_cost_per_link_btn_click":{"type":"string"},"marketing_messages_delivered":{"type":"string"},"marketing_messages_delivery_rate":{"type":"string"},"marketing_messages_link_btn_click":{"type":"string"},"marketing_messages_link_btn_click_rate":{"type":"string"},"marketing_messages_media_view_rate":{"type":"string"},"marketing_messages_phone_call_btn_click_rate":{"type":"string"},"marketing_messages_quick_reply_btn_click":{"type":"string"},"marketing_messages_quick_reply_btn_click_rate":{"type":"string"},"marketing_messages_read":{"type":"string"},"marketing_messages_read_rate":{"type":"string"},"marketing_messages_read_rate_benchmark":{"type":"string"},"marketing_messages_sent":{"type":"string"},"marketing_messages_spend":{"type":"string"},"marketing_messages_spend_currency":{"type":"string"},"marketing_messages_website_add_to_cart":{"type":"string"},"marketing_messages_website_initiate_checkout":{"type":"string"},"marketing_messages_website_purchase":{"type":"string"},"marketing_messages_website_purchase_values":{"type":"string"},"mobile_app_purchase_roas":{"items":{"type":"object"},"type":"array"},"objective":{"type":"string"},"objective_result_rate":{"items":{"type":"object"},"type":"array"},"objective_results":{"items":{"type":"object"},"type":"array"},"onsite_conversion_messaging_detected_purchase_deduped":{"items":{"type":"object"},"type":"array"},"optimization_goal":{"type":"string"},"outbound_clicks":{"items":{"type":"object"},"type":"array"},"outbound_clicks_ctr":{"items":{"type":"object"},"type":"array"},"place_page_name":{"type":"string"},"product_brand":{"type":"string"},"product_category":{"type":"string"},"product_content_id":{"type":"string"},"product_custom_label_0":{"type":"string"},"product_custom_label_1":{"type":"string"},"product_custom_label_2":{"type":"string"},"product_custom_label_3":{"type":"string"},"product_custom_label_4":{"type":"string"},"product_group_content_id":{"type":"string"},"product_group_retailer_id":{"type":"string"},"product_name":{"type":"string"},"product_retailer_id":{"type":"string"},"purchase_per_landing_page_view":{"type":"string"},"purchase_roas":{"items":{"type":"object"},"type":"array"},"purchases_per_link_click":{"type":"string"},"qualifying_question_qualify_answer_rate":{"type":"string"},"quality_ranking":{"type":"string"},"reach":{"type":"string"},"result_rate":{"items":{"type":"object"},"type":"array"},"result_values_performance_indicator":{"type":"string"},"results":{"items":{"type":"object"},"type":"array"},"shops_assisted_purchases":{"type":"string"},"social_spend":{"type":"string"},"spend":{"type":"string"},"total_postbacks":{"type":"string"},"total_postbacks_detailed":{"items":{"type":"object"},"type":"array"},"total_postbacks_detailed_v4":{"items":{"type":"object"},"type":"array"},"unique_actions":{"items":{"type":"object"},"type":"array"},"unique_clicks":{"type":"string"},"unique_conversions":{"items":{"type":"object"},"type":"array"},"unique_ctr":{"type":"string"},"unique_inline_link_click_ctr":{"type":"string"},"unique_inline_link_clicks":{"type":"string"},"unique_link_clicks_ctr":{"type":"string"},"unique_outbound_clicks":{"items":{"type":"object"},"type":"array"},"unique_outbound_clicks_ctr":{"items":{"type":"object"},"type":"array"},"unique_video_continuous_2_sec_watched_actions":{"items":{"type":"object"},"type":"array"},"unique_video_view_15_sec":{"items":{"type":"object"},"type":"array"},"updated_time":{"type":"string"},"video_15_sec_watched_actions":{"items":{"type":"object"},"type":"array"},"video_30_sec_watched_actions":{"items":{"type":"object"},"type":"array"},"video_avg_time_watched_actions":{"items":{"type":"object"},"type":"array"},"video_continuous_2_sec_watched_actions":{"items":{"type":"object"},"type":"array"},"video_p100_watched_actions":{"items":{"type":"object"},"type":"array"},"video_p25_watched_actions":{"items":{"type":"object"},"type":"array"},"video_p50_watched_actions":{"items":{"type":"object"},"type":"array"},"video_p75_watched_actions":{"items":{"type":"object"},"type":"array"},"video_p95_watched_actions":{"items":{"type":"object"},"type":"array"},"video_play_actions":{"items":{"type":"object"},"type":"array"},"video_play_curve_actions":{"items":{"type":"object"},"type":"array"},"video_play_retention_0_to_15s_actions":{"items":{"type":"object"},"type":"array"},"video_play_retention_20_to_60s_actions":{"items":{"type":"object"},"type":"array"},"video_play_retention_graph_actions":{"items":{"type":"object"},"type":"array"},"video_thruplay_watched_actions":{"items":{"type":"object"},"type":"array"},"video_time_watched_actions":{"items":{"type":"object"},"type":"array"},"video_view_per_impression":{"items":{"type":"object"},"type":"array"},"website_ctr":{"items":{"type":"object"},"type":"array"},"website_purchase_roas":{"items":{"type":"object"},"type":"array"},"wish_bid":{"type":"string"}},"type":"object"},"type":"array"},"pagination":{"type":"object"},"paging":{"type":"object"},"summary":{"type":"object"}},"type":"object"}`)
+	tool.Annotations = common.ReadOnlyAnnotation("Ad: Get Insights")
 
 	s.AddTool(tool, AdGetInsightsHandler)
 	return nil
@@ -57,6 +91,10 @@ func RegisterAdGetInsightsHandler(s *server.MCPServer) error {
 
 // AdGetInsightsHandler handles the ad_get_insights tool
 func AdGetInsightsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if problems := common.ValidateArguments(request.GetArguments(), ad_get_insightsRules); len(problems) > 0 {
+		return common.ValidationErrorResult(problems), nil
+	}
+
 	var args ad_get_insightsArgs
 	if err := request.BindArguments(&args); err != nil {
 		return common.HandleBindError(err)
@@ -79,22 +117,32 @@ func AdGetInsightsHandler(ctx context.Context, request mcp.CallToolRequest) (*mc
 	}
 	if len(args.ActionAttributionWindows) > 0 {
 		params["action_attribution_windows"] = strings.Join(args.ActionAttributionWindows, ",")
+	} else if args.ActionAttributionWindows != nil {
+		params["action_attribution_windows"] = "[]"
 	}
 	if len(args.ActionBreakdowns) > 0 {
 		params["action_breakdowns"] = strings.Join(args.ActionBreakdowns, ",")
+	} else if args.ActionBreakdowns != nil {
+		params["action_breakdowns"] = "[]"
 	}
 	if args.ActionReportTime != "" {
 		params["action_report_time"] = args.ActionReportTime
 	}
 	if len(args.Breakdowns) > 0 {
 		params["breakdowns"] = strings.Join(args.Breakdowns, ",")
+	} else if args.Breakdowns != nil {
+		params["breakdowns"] = "[]"
 	}
 	if args.DatePreset != "" {
 		params["date_preset"] = args.DatePreset
 	}
-	params["default_summary"] = fmt.Sprintf("%v", args.DefaultSummary)
+	if args.DefaultSummary != nil {
+		params["default_summary"] = fmt.Sprintf("%v", *args.DefaultSummary)
+	}
 	if len(args.ExportColumns) > 0 {
 		params["export_columns"] = strings.Join(args.ExportColumns, ",")
+	} else if args.ExportColumns != nil {
+		params["export_columns"] = "[]"
 	}
 	if args.ExportFormat != "" {
 		params["export_format"] = args.ExportFormat
@@ -102,29 +150,45 @@ func AdGetInsightsHandler(ctx context.Context, request mcp.CallToolRequest) (*mc
 	if args.ExportName != "" {
 		params["export_name"] = args.ExportName
 	}
-	// TODO: Handle []map[string]interface{} for Filtering
+	if err := common.SetJSONParam(params, "filtering", args.Filtering); err != nil {
+		return common.HandleBindError(err)
+	}
 	if args.Level != "" {
 		params["level"] = args.Level
 	}
-	if args.ProductIdLimit > 0 {
-		params["product_id_limit"] = fmt.Sprintf("%d", args.ProductIdLimit)
+	if args.ProductIdLimit != nil {
+		params["product_id_limit"] = fmt.Sprintf("%v", *args.ProductIdLimit)
 	}
 	if len(args.Sort) > 0 {
 		params["sort"] = strings.Join(args.Sort, ",")
+	} else if args.Sort != nil {
+		params["sort"] = "[]"
 	}
 	if len(args.Summary) > 0 {
 		params["summary"] = strings.Join(args.Summary, ",")
+	} else if args.Summary != nil {
+		params["summary"] = "[]"
 	}
 	if len(args.SummaryActionBreakdowns) > 0 {
 		params["summary_action_breakdowns"] = strings.Join(args.SummaryActionBreakdowns, ",")
+	} else if args.SummaryActionBreakdowns != nil {
+		params["summary_action_breakdowns"] = "[]"
 	}
 	if args.TimeIncrement != "" {
 		params["time_increment"] = args.TimeIncrement
 	}
-	// TODO: Handle map[string]interface{} for TimeRange
-	// TODO: Handle []map[string]interface{} for TimeRanges
-	params["use_account_attribution_setting"] = fmt.Sprintf("%v", args.UseAccountAttributionSetting)
-	params["use_unified_attribution_setting"] = fmt.Sprintf("%v", args.UseUnifiedAttributionSetting)
+	if err := common.SetJSONParam(params, "time_range", args.TimeRange); err != nil {
+		return common.HandleBindError(err)
+	}
+	if err := common.SetJSONParam(params, "time_ranges", args.TimeRanges); err != nil {
+		return common.HandleBindError(err)
+	}
+	if args.UseAccountAttributionSetting != nil {
+		params["use_account_attribution_setting"] = fmt.Sprintf("%v", *args.UseAccountAttributionSetting)
+	}
+	if args.UseUnifiedAttributionSetting != nil {
+		params["use_unified_attribution_setting"] = fmt.Sprintf("%v", *args.UseUnifiedAttributionSetting)
+	}
 
 	result, err := common.MakeGraphAPIRequest(ctx, "GET", endpoint, params, nil)
 
@@ -132,9 +196,9 @@ func AdGetInsightsHandler(ctx context.Context, request mcp.CallToolRequest) (*mc
 		return common.HandleAPIError(err)
 	}
 
-	return &mcp.CallToolResult{
-		Content: []mcp.Content{
-			mcp.NewTextContent(string(result)),
-		},
-	}, nil
+	if args.Structured {
+		return common.StructuredListResult[common.AdsInsights](result)
+	}
+
+	return common.GraphResult[common.AdsInsights](result, true)
 }
@@ -16,10 +16,27 @@ import (
 
 // ad_list_adcreativesArgs defines the typed arguments for ad_list_adcreatives
 type ad_list_adcreativesArgs struct {
-	Fields []string `json:"fields,omitempty" jsonschema:"description=Fields to return"`
-	Limit  int      `json:"limit,omitempty" jsonschema:"description=Maximum number of results,minimum=1,maximum=100"`
-	After  string   `json:"after,omitempty" jsonschema:"description=Cursor for pagination (next page)"`
-	Before string   `json:"before,omitempty" jsonschema:"description=Cursor for pagination (previous page)"`
+	Fields     []string `json:"fields,omitempty" jsonschema:"description=Fields to return"`
+	Limit      int      `json:"limit,omitempty" jsonschema:"description=Maximum number of results,minimum=1,maximum=100"`
+	After      string   `json:"after,omitempty" jsonschema:"description=Cursor for pagination (next page)"`
+	Before     string   `json:"before,omitempty" jsonschema:"description=Cursor for pagination (previous page)"`
+	FetchAll   bool     `json:"fetch_all,omitempty" jsonschema:"description=Follow paging.next and merge all pages"`
+	MaxItems   int      `json:"max_items,omitempty" jsonschema:"description=Maximum number of items to return across pages,minimum=1,maximum=5000"`
+	MaxPages   int      `json:"max_pages,omitempty" jsonschema:"description=Maximum number of pages to fetch,minimum=1,maximum=50"`
+	Structured bool     `json:"structured,omitempty" jsonschema:"description=Decode the response into typed AdCreative objects and return structured content"`
+}
+
+// ad_list_adcreativesRules are checked against the raw arguments before calling the Graph API
+var ad_list_adcreativesRules = []common.ParamRule{
+	{Name: "after", Type: "string"},
+	{Name: "api_version", Type: "string", Pattern: "^v[0-9]+\\.[0-9]+$"},
+	{Name: "before", Type: "string"},
+	{Name: "fetch_all", Type: "boolean"},
+	{Name: "fields", Type: "array"},
+	{Name: "limit", Type: "integer", Minimum: common.Bound(1), Maximum: common.Bound(100)},
+	{Name: "max_items", Type: "integer", Minimum: common.Bound(1), Maximum: common.Bound(5000)},
+	{Name: "max_pages", Type: "integer", Minimum: common.Bound(1), Maximum: common.Bound(50)},
+	{Name: "structured", Type: "boolean"},
 }
 
 // RegisterAdListAdcreativesHandler registers the ad_list_adcreatives tool
@@ -27,8 +44,10 @@ func RegisterAdListAdcreativesHandler(s *server.MCPServer) error {
 	tool := mcp.NewToolWithRawSchema(
 		"ad_list_adcreatives",
 		"List adcreatives for this Ad Returns AdCreative.",
-		json.RawMessage(`{"additionalProperties":false,"properties":{"after":{"description":"Cursor for pagination (next page)","type":"string"},"before":{"description":"Cursor for pagination (previous page)","type":"string"},"fields":{"description":"Fields to return","items":{"type":"string"},"type":"array"},"limit":{"description":"Maximum number of results","maximum":100,"minimum":1,"type":"integer"}},"required":[],"type":"object"}`),
+		json.RawMessage(`{"additionalProperties":false,"properties":{"after":{"description":"Cursor for pagination (next page)","type":"string"},"api_version":{"description":"Graph API version for this call, e.g. \"v23.0\" (defaults to FACEBOOK_API_VERSION)","pattern":"^v[0-9]+\\.[0-9]+$","type":"string"},"before":{"description":"Cursor for pagination (previous page)","type":"string"},"fetch_all":{"description":"Follow paging.next and return all pages merged into one data array (subject to max_items/max_pages and a hard safety cap)","type":"boolean"},"fields":{"description":"Fields to return","items":{"type":"string"},"type":"array"},"limit":{"description":"Maximum number of results","maximum":100,"minimum":1,"type":"integer"},"max_items":{"description":"Maximum number of items to return across pages","maximum":5000,"minimum":1,"type":"integer"},"max_pages":{"description":"Maximum number of pages to fetch","maximum":50,"minimum":1,"type":"integer"},"structured":{"description":"Decode the response into typed AdCreative objects, with RFC 3339 UTC timestamps, and return it as structured content","type":"boolean"}},"required":[],"type":"object"}`),
 	)
+	tool.RawOutputSchema = json.RawMessage(`{"additionalProperties":true,"properties":{"data":{"items":{"additionalProperties":true,"properties":{"account_id":{"type":"string"},"actor_id":{"type":"string"},"ad_disclaimer_spec":{"type":"object"},"adlabels":{"items":{"type":"object"},"type":"array"},"applink_treatment":{"type":"string"},"asset_feed_spec":{"type":"object"},"authorization_category":{"type":"string"},"auto_update":{"type":"boolean"},"body":{"type":"string"},"branded_content":{"type":"object"},"branded_content_sponsor_page_id":{"type":"string"},"bundle_folder_id":{"type":"string"},"call_to_action":{"type":"object"},"call_to_action_type":{"type":"string"},"categorization_criteria":{"type":"string"},"category_media_source":{"type":"string"},"collaborative_ads_lsb_image_bank_id":{"type":"string"},"contextual_multi_ads":{"type":"object"},"creative_sourcing_spec":{"type":"object"},"degrees_of_freedom_spec":{"type":"object"},"destination_set_id":{"type":"string"},"dynamic_ad_voice":{"type":"string"},"effective_authorization_category":{"type":"string"},"effective_instagram_media_id":{"type":"string"},"effective_object_story_id":{"type":"string"},"enable_direct_install":{"type":"boolean"},"enable_launch_instant_app":{"type":"boolean"},"facebook_branded_content":{"type":"object"},"id":{"type":"string"},"image_crops":{"type":"object"},"image_hash":{"type":"string"},"image_url":{"type":"string"},"instagram_branded_content":{"type":"object"},"instagram_permalink_url":{"type":"string"},"instagram_user_id":{"type":"string"},"interactive_components_spec":{"type":"object"},"link_deep_link_url":{"type":"string"},"link_destination_display_url":{"type":"string"},"link_og_id":{"type":"string"},"link_url":{"type":"string"},"messenger_sponsored_message":{"type":"string"},"name":{"type":"string"},"object_id":{"type":"string"},"object_store_url":{"type":"string"},"object_story_id":{"type":"string"},"object_story_spec":{"type":"object"},"object_type":{"type":"string"},"object_url":{"type":"string"},"omnichannel_link_spec":{"type":"object"},"page_welcome_message":{"type":"string"},"photo_album_source_object_story_id":{"type":"string"},"place_page_set_id":{"type":"string"},"platform_customizations":{"type":"object"},"playable_asset_id":{"type":"string"},"portrait_customizations":{"type":"object"},"product_data":{"items":{"type":"object"},"type":"array"},"product_set_id":{"type":"string"},"recommender_settings":{"type":"object"},"regional_regulation_disclaimer_spec":{"type":"object"},"source_facebook_post_id":{"type":"string"},"source_instagram_media_id":{"type":"string"},"status":{"type":"string"},"template_url":{"type":"string"},"template_url_spec":{"type":"object"},"thumbnail_id":{"type":"string"},"thumbnail_url":{"type":"string"},"title":{"type":"string"},"url_tags":{"type":"string"},"use_page_actor_override":{"type":"boolean"},"video_id":{"type":"string"}},"type":"object"},"type":"array"},"pagination":{"type":"object"},"paging":{"type":"object"},"summary":{"type":"object"}},"type":"object"}`)
+	tool.Annotations = common.ReadOnlyAnnotation("Ad: List Adcreatives")
 
 	s.AddTool(tool, AdListAdcreativesHandler)
 	return nil
@@ -36,6 +55,10 @@ func RegisterAdListAdcreativesHandler(s *server.MCPServer) error {
 
 // AdListAdcreativesHandler handles the ad_list_adcreatives tool
 func AdListAdcreativesHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if problems := common.ValidateArguments(request.GetArguments(), ad_list_adcreativesRules); len(problems) > 0 {
+		return common.ValidationErrorResult(problems), nil
+	}
+
 	var args ad_list_adcreativesArgs
 	if err := request.BindArguments(&args); err != nil {
 		return common.HandleBindError(err)
@@ -56,15 +79,20 @@ func AdListAdcreativesHandler(ctx context.Context, request mcp.CallToolRequest)
 		params["before"] = args.Before
 	}
 
-	result, err := common.MakeGraphAPIRequest(ctx, "GET", endpoint, params, nil)
+	pagination := common.PaginationOptions{
+		FetchAll: args.FetchAll,
+		MaxItems: args.MaxItems,
+		MaxPages: args.MaxPages,
+	}
+	result, err := common.MakePaginatedGraphAPIRequest(ctx, endpoint, params, pagination)
 
 	if err != nil {
 		return common.HandleAPIError(err)
 	}
 
-	return &mcp.CallToolResult{
-		Content: []mcp.Content{
-			mcp.NewTextContent(string(result)),
-		},
-	}, nil
+	if args.Structured {
+		return common.StructuredListResult[common.AdCreative](result)
+	}
+
+	return common.GraphResult[common.AdCreative](result, true)
 }
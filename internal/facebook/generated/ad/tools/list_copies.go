@@ -24,7 +24,29 @@ type ad_list_copiesArgs struct {
 	DatePreset      string                 `json:"date_preset,omitempty" jsonschema:"description=Date Preset"`
 	EffectiveStatus []string               `json:"effective_status,omitempty" jsonschema:"description=Effective Status"`
 	TimeRange       map[string]interface{} `json:"time_range,omitempty" jsonschema:"description=Time Range"`
-	UpdatedSince    int                    `json:"updated_since,omitempty" jsonschema:"description=When last updated"`
+	UpdatedSince    *int                   `json:"updated_since,omitempty" jsonschema:"description=When last updated"`
+	FetchAll        bool                   `json:"fetch_all,omitempty" jsonschema:"description=Follow paging.next and merge all pages"`
+	MaxItems        int                    `json:"max_items,omitempty" jsonschema:"description=Maximum number of items to return across pages,minimum=1,maximum=5000"`
+	MaxPages        int                    `json:"max_pages,omitempty" jsonschema:"description=Maximum number of pages to fetch,minimum=1,maximum=50"`
+	Structured      bool                   `json:"structured,omitempty" jsonschema:"description=Decode the response into typed Ad objects and return structured content"`
+}
+
+// ad_list_copiesRules are checked against the raw arguments before calling the Graph API
+var ad_list_copiesRules = []common.ParamRule{
+	{Name: "after", Type: "string"},
+	{Name: "api_version", Type: "string", Pattern: "^v[0-9]+\\.[0-9]+$"},
+	{Name: "before", Type: "string"},
+	{Name: "date_preset", Type: "string", Enum: common.AdgroupcopiesDatePresetEnumParamValues},
+	{Name: "effective_status", Type: "array"},
+	{Name: "fetch_all", Type: "boolean"},
+	{Name: "fields", Type: "array"},
+	{Name: "id", Type: "string", Required: true, Pattern: "^[0-9]+$"},
+	{Name: "limit", Type: "integer", Minimum: common.Bound(1), Maximum: common.Bound(100)},
+	{Name: "max_items", Type: "integer", Minimum: common.Bound(1), Maximum: common.Bound(5000)},
+	{Name: "max_pages", Type: "integer", Minimum: common.Bound(1), Maximum: common.Bound(50)},
+	{Name: "structured", Type: "boolean"},
+	{Name: "time_range", Type: "object"},
+	{Name: "updated_since", Type: "integer"},
 }
 
 // RegisterAdListCopiesHandler registers the ad_list_copies tool
@@ -32,8 +54,10 @@ func RegisterAdListCopiesHandler(s *server.MCPServer) error {
 	tool := mcp.NewToolWithRawSchema(
 		"ad_list_copies",
 		"List copies for this Ad Returns Ad.",
-		json.RawMessage(`{"additionalProperties":false,"properties":{"after":{"description":"Cursor for pagination (next page)","type":"string"},"before":{"description":"Cursor for pagination (previous page)","type":"string"},"date_preset":{"description":"Date Preset (enum: adgroupcopies_date_preset_enum_param)","enum":["data_maximum","last_14d","last_28d","last_30d","last_3d","last_7d","last_90d","last_month","last_quarter","last_week_mon_sun","last_week_sun_sat","last_year","maximum","this_month","this_quarter","this_week_mon_today","this_week_sun_today","this_year","today","yesterday"],"type":"string"},"effective_status":{"description":"Effective Status","items":{"type":"string"},"type":"array"},"fields":{"description":"Fields to return","items":{"type":"string"},"type":"array"},"id":{"description":"Ad ID","pattern":"^[0-9]+$","type":"string"},"limit":{"description":"Maximum number of results","maximum":100,"minimum":1,"type":"integer"},"time_range":{"description":"Time Range","type":"string"},"updated_since":{"description":"When last updated","type":"integer"}},"required":["id"],"type":"object"}`),
+		json.RawMessage(`{"additionalProperties":false,"properties":{"after":{"description":"Cursor for pagination (next page)","type":"string"},"api_version":{"description":"Graph API version for this call, e.g. \"v23.0\" (defaults to FACEBOOK_API_VERSION)","pattern":"^v[0-9]+\\.[0-9]+$","type":"string"},"before":{"description":"Cursor for pagination (previous page)","type":"string"},"date_preset":{"description":"Date Preset (enum: adgroupcopies_date_preset_enum_param)","enum":["data_maximum","last_14d","last_28d","last_30d","last_3d","last_7d","last_90d","last_month","last_quarter","last_week_mon_sun","last_week_sun_sat","last_year","maximum","this_month","this_quarter","this_week_mon_today","this_week_sun_today","this_year","today","yesterday"],"type":"string"},"effective_status":{"description":"Effective Status","items":{"type":"string"},"type":"array"},"fetch_all":{"description":"Follow paging.next and return all pages merged into one data array (subject to max_items/max_pages and a hard safety cap)","type":"boolean"},"fields":{"description":"Fields to return","items":{"type":"string"},"type":"array"},"id":{"description":"Ad ID","pattern":"^[0-9]+$","type":"string"},"limit":{"description":"Maximum number of results","maximum":100,"minimum":1,"type":"integer"},"max_items":{"description":"Maximum number of items to return across pages","maximum":5000,"minimum":1,"type":"integer"},"max_pages":{"description":"Maximum number of pages to fetch","maximum":50,"minimum":1,"type":"integer"},"structured":{"description":"Decode the response into typed Ad objects, with RFC 3339 UTC timestamps, and return it as structured content","type":"boolean"},"time_range":{"additionalProperties":true,"description":"Time Range","type":"object"},"updated_since":{"description":"When last updated","type":"integer"}},"required":["id"],"type":"object"}`),
 	)
+	tool.RawOutputSchema = json.RawMessage(`{"additionalProperties":true,"properties":{"data":{"items":{"additionalProperties":true,"properties":{"account_id":{"type":"string"},"ad_active_time":{"type":"string"},"ad_review_feedback":{"type":"object"},"ad_schedule_end_time":{"format":"date-time","type":"string"},"ad_schedule_start_time":{"format":"date-time","type":"string"},"adlabels":{"items":{"type":"object"},"type":"array"},"adset":{"type":"object"},"adset_id":{"type":"string"},"bid_amount":{"type":"integer"},"bid_info":{"type":"object"},"bid_type":{"type":"string"},"campaign":{"type":"object"},"campaign_id":{"type":"string"},"configured_status":{"type":"string"},"conversion_domain":{"type":"string"},"conversion_specs":{"items":{"type":"object"},"type":"array"},"created_time":{"format":"date-time","type":"string"},"creative":{"type":"object"},"creative_asset_groups_spec":{"type":"object"},"demolink_hash":{"type":"string"},"display_sequence":{"type":"integer"},"effective_status":{"type":"string"},"engagement_audience":{"type":"boolean"},"failed_delivery_checks":{"items":{"type":"object"},"type":"array"},"id":{"type":"string"},"issues_info":{"items":{"type":"object"},"type":"array"},"last_updated_by_app_id":{"type":"string"},"name":{"type":"string"},"placement":{"type":"object"},"preview_shareable_link":{"type":"string"},"priority":{"type":"integer"},"recommendations":{"items":{"type":"object"},"type":"array"},"source_ad":{"type":"object"},"source_ad_id":{"type":"string"},"status":{"type":"string"},"targeting":{"type":"object"},"tracking_and_conversion_with_defaults":{"type":"object"},"tracking_specs":{"items":{"type":"object"},"type":"array"},"updated_time":{"format":"date-time","type":"string"}},"type":"object"},"type":"array"},"pagination":{"type":"object"},"paging":{"type":"object"},"summary":{"type":"object"}},"type":"object"}`)
+	tool.Annotations = common.ReadOnlyAnnotation("Ad: List Copies")
 
 	s.AddTool(tool, AdListCopiesHandler)
 	return nil
@@ -41,6 +65,10 @@ func RegisterAdListCopiesHandler(s *server.MCPServer) error {
 
 // AdListCopiesHandler handles the ad_list_copies tool
 func AdListCopiesHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if problems := common.ValidateArguments(request.GetArguments(), ad_list_copiesRules); len(problems) > 0 {
+		return common.ValidationErrorResult(problems), nil
+	}
+
 	var args ad_list_copiesArgs
 	if err := request.BindArguments(&args); err != nil {
 		return common.HandleBindError(err)
@@ -66,21 +94,30 @@ func AdListCopiesHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp
 	}
 	if len(args.EffectiveStatus) > 0 {
 		params["effective_status"] = strings.Join(args.EffectiveStatus, ",")
+	} else if args.EffectiveStatus != nil {
+		params["effective_status"] = "[]"
 	}
-	// TODO: Handle map[string]interface{} for TimeRange
-	if args.UpdatedSince > 0 {
-		params["updated_since"] = fmt.Sprintf("%d", args.UpdatedSince)
+	if err := common.SetJSONParam(params, "time_range", args.TimeRange); err != nil {
+		return common.HandleBindError(err)
+	}
+	if args.UpdatedSince != nil {
+		params["updated_since"] = fmt.Sprintf("%v", *args.UpdatedSince)
 	}
 
-	result, err := common.MakeGraphAPIRequest(ctx, "GET", endpoint, params, nil)
+	pagination := common.PaginationOptions{
+		FetchAll: args.FetchAll,
+		MaxItems: args.MaxItems,
+		MaxPages: args.MaxPages,
+	}
+	result, err := common.MakePaginatedGraphAPIRequest(ctx, endpoint, params, pagination)
 
 	if err != nil {
 		return common.HandleAPIError(err)
 	}
 
-	return &mcp.CallToolResult{
-		Content: []mcp.Content{
-			mcp.NewTextContent(string(result)),
-		},
-	}, nil
+	if args.Structured {
+		return common.StructuredListResult[common.Ad](result)
+	}
+
+	return common.GraphResult[common.Ad](result, true)
 }
@@ -27,14 +27,46 @@ type ad_list_previewsArgs struct {
 	DynamicCreativeSpec  map[string]interface{} `json:"dynamic_creative_spec,omitempty" jsonschema:"description=Dynamic Creative Spec"`
 	DynamicCustomization map[string]interface{} `json:"dynamic_customization,omitempty" jsonschema:"description=Dynamic Customization"`
 	EndDate              string                 `json:"end_date,omitempty" jsonschema:"description=End Date,format=date-time"`
-	Height               int                    `json:"height,omitempty" jsonschema:"description=Height"`
+	Height               *int                   `json:"height,omitempty" jsonschema:"description=Height"`
 	Locale               string                 `json:"locale,omitempty" jsonschema:"description=Locale"`
-	PlacePageId          int                    `json:"place_page_id,omitempty" jsonschema:"description=ID of the Place Page,pattern=^[0-9]+$"`
+	PlacePageId          *int                   `json:"place_page_id,omitempty" jsonschema:"description=ID of the Place Page,pattern=^[0-9]+$"`
 	Post                 map[string]interface{} `json:"post,omitempty" jsonschema:"description=Post"`
 	ProductItemIds       []string               `json:"product_item_ids,omitempty" jsonschema:"description=Product Item Ids,pattern=^[0-9]+$"`
 	RenderType           string                 `json:"render_type,omitempty" jsonschema:"description=Render Type"`
 	StartDate            string                 `json:"start_date,omitempty" jsonschema:"description=Start Date,format=date-time"`
-	Width                int                    `json:"width,omitempty" jsonschema:"description=Width"`
+	Width                *int                   `json:"width,omitempty" jsonschema:"description=Width"`
+	FetchAll             bool                   `json:"fetch_all,omitempty" jsonschema:"description=Follow paging.next and merge all pages"`
+	MaxItems             int                    `json:"max_items,omitempty" jsonschema:"description=Maximum number of items to return across pages,minimum=1,maximum=5000"`
+	MaxPages             int                    `json:"max_pages,omitempty" jsonschema:"description=Maximum number of pages to fetch,minimum=1,maximum=50"`
+	Structured           bool                   `json:"structured,omitempty" jsonschema:"description=Decode the response into typed AdPreview objects and return structured content"`
+}
+
+// ad_list_previewsRules are checked against the raw arguments before calling the Graph API
+var ad_list_previewsRules = []common.ParamRule{
+	{Name: "ad_format", Type: "string", Required: true, Enum: common.AdgrouppreviewsAdFormatEnumParamValues},
+	{Name: "after", Type: "string"},
+	{Name: "api_version", Type: "string", Pattern: "^v[0-9]+\\.[0-9]+$"},
+	{Name: "before", Type: "string"},
+	{Name: "creative_feature", Type: "string", Enum: common.AdgrouppreviewsCreativeFeatureEnumParamValues},
+	{Name: "dynamic_asset_label", Type: "string"},
+	{Name: "dynamic_creative_spec", Type: "object"},
+	{Name: "dynamic_customization", Type: "object"},
+	{Name: "end_date", Type: "string"},
+	{Name: "fetch_all", Type: "boolean"},
+	{Name: "fields", Type: "array"},
+	{Name: "height", Type: "integer"},
+	{Name: "id", Type: "string", Required: true, Pattern: "^[0-9]+$"},
+	{Name: "limit", Type: "integer", Minimum: common.Bound(1), Maximum: common.Bound(100)},
+	{Name: "locale", Type: "string"},
+	{Name: "max_items", Type: "integer", Minimum: common.Bound(1), Maximum: common.Bound(5000)},
+	{Name: "max_pages", Type: "integer", Minimum: common.Bound(1), Maximum: common.Bound(50)},
+	{Name: "place_page_id", Type: "integer", Pattern: "^[0-9]+$"},
+	{Name: "post", Type: "object"},
+	{Name: "product_item_ids", Type: "array"},
+	{Name: "render_type", Type: "string", Enum: common.AdgrouppreviewsRenderTypeEnumParamValues},
+	{Name: "start_date", Type: "string"},
+	{Name: "structured", Type: "boolean"},
+	{Name: "width", Type: "integer"},
 }
 
 // RegisterAdListPreviewsHandler registers the ad_list_previews tool
@@ -42,8 +74,10 @@ func RegisterAdListPreviewsHandler(s *server.MCPServer) error {
 	tool := mcp.NewToolWithRawSchema(
 		"ad_list_previews",
 		"List previews for this Ad Returns AdPreview. Required: ad_format (enum)",
-		json.RawMessage(`{"additionalProperties":false,"properties":{"ad_format":{"description":"Ad Format (enum: adgrouppreviews_ad_format_enum_param)","enum":["AUDIENCE_NETWORK_INSTREAM_VIDEO","AUDIENCE_NETWORK_INSTREAM_VIDEO_MOBILE","AUDIENCE_NETWORK_OUTSTREAM_VIDEO","AUDIENCE_NETWORK_REWARDED_VIDEO","BIZ_DISCO_FEED_MOBILE","DESKTOP_FEED_STANDARD","FACEBOOK_PROFILE_FEED_DESKTOP","FACEBOOK_PROFILE_FEED_MOBILE","FACEBOOK_PROFILE_REELS_MOBILE","FACEBOOK_REELS_BANNER","FACEBOOK_REELS_BANNER_DESKTOP","FACEBOOK_REELS_BANNER_FULLSCREEN_IOS","FACEBOOK_REELS_BANNER_FULLSCREEN_MOBILE","FACEBOOK_REELS_MOBILE","FACEBOOK_REELS_POSTLOOP","FACEBOOK_REELS_STICKER","FACEBOOK_STORY_MOBILE","FACEBOOK_STORY_STICKER_MOBILE","INSTAGRAM_EXPLORE_CONTEXTUAL","INSTAGRAM_EXPLORE_GRID_HOME","INSTAGRAM_EXPLORE_IMMERSIVE","INSTAGRAM_FEED_WEB","INSTAGRAM_FEED_WEB_M_SITE","INSTAGRAM_LEAD_GEN_MULTI_SUBMIT_ADS","INSTAGRAM_PROFILE_FEED","INSTAGRAM_PROFILE_REELS","INSTAGRAM_REELS","INSTAGRAM_REELS_OVERLAY","INSTAGRAM_SEARCH_CHAIN","INSTAGRAM_SEARCH_GRID","INSTAGRAM_STANDARD","INSTAGRAM_STORY","INSTAGRAM_STORY_EFFECT_TRAY","INSTAGRAM_STORY_WEB","INSTAGRAM_STORY_WEB_M_SITE","INSTANT_ARTICLE_RECIRCULATION_AD","INSTANT_ARTICLE_STANDARD","INSTREAM_BANNER_DESKTOP","INSTREAM_BANNER_FULLSCREEN_IOS","INSTREAM_BANNER_FULLSCREEN_MOBILE","INSTREAM_BANNER_IMMERSIVE_MOBILE","INSTREAM_BANNER_MOBILE","INSTREAM_VIDEO_DESKTOP","INSTREAM_VIDEO_FULLSCREEN_IOS","INSTREAM_VIDEO_FULLSCREEN_MOBILE","INSTREAM_VIDEO_IMAGE","INSTREAM_VIDEO_IMMERSIVE_MOBILE","INSTREAM_VIDEO_MOBILE","JOB_BROWSER_DESKTOP","JOB_BROWSER_MOBILE","MARKETPLACE_MOBILE","MESSENGER_MOBILE_INBOX_MEDIA","MESSENGER_MOBILE_STORY_MEDIA","MOBILE_BANNER","MOBILE_FEED_BASIC","MOBILE_FEED_STANDARD","MOBILE_FULLWIDTH","MOBILE_INTERSTITIAL","MOBILE_MEDIUM_RECTANGLE","MOBILE_NATIVE","RIGHT_COLUMN_STANDARD","SUGGESTED_VIDEO_DESKTOP","SUGGESTED_VIDEO_FULLSCREEN_MOBILE","SUGGESTED_VIDEO_IMMERSIVE_MOBILE","SUGGESTED_VIDEO_MOBILE","WATCH_FEED_HOME","WATCH_FEED_MOBILE"],"type":"string"},"after":{"description":"Cursor for pagination (next page)","type":"string"},"before":{"description":"Cursor for pagination (previous page)","type":"string"},"creative_feature":{"description":"Creative Feature (enum: adgrouppreviews_creative_feature_enum_param)","enum":["product_metadata_automation","profile_card","standard_enhancements_catalog","video_to_image"],"type":"string"},"dynamic_asset_label":{"description":"Dynamic Asset Label","type":"string"},"dynamic_creative_spec":{"additionalProperties":true,"description":"Dynamic Creative Spec","type":"object"},"dynamic_customization":{"additionalProperties":true,"description":"Dynamic Customization","type":"object"},"end_date":{"description":"End Date","type":"string"},"fields":{"description":"Fields to return","items":{"type":"string"},"type":"array"},"height":{"description":"Height","type":"integer"},"id":{"description":"Ad ID","pattern":"^[0-9]+$","type":"string"},"limit":{"description":"Maximum number of results","maximum":100,"minimum":1,"type":"integer"},"locale":{"description":"Locale","type":"string"},"place_page_id":{"description":"ID of the Place Page","pattern":"^[0-9]+$","type":"integer"},"post":{"additionalProperties":true,"description":"Post","type":"object"},"product_item_ids":{"description":"Product Item Ids","items":{"type":"string"},"type":"array"},"render_type":{"description":"Render Type (enum: adgrouppreviews_render_type_enum_param)","enum":["FALLBACK"],"type":"string"},"start_date":{"description":"Start Date","type":"string"},"width":{"description":"Width","type":"integer"}},"required":["id","ad_format"],"type":"object"}`),
+		json.RawMessage(`{"additionalProperties":false,"properties":{"ad_format":{"description":"Ad Format (enum: adgrouppreviews_ad_format_enum_param)","enum":["AUDIENCE_NETWORK_INSTREAM_VIDEO","AUDIENCE_NETWORK_INSTREAM_VIDEO_MOBILE","AUDIENCE_NETWORK_OUTSTREAM_VIDEO","AUDIENCE_NETWORK_REWARDED_VIDEO","BIZ_DISCO_FEED_MOBILE","DESKTOP_FEED_STANDARD","FACEBOOK_PROFILE_FEED_DESKTOP","FACEBOOK_PROFILE_FEED_MOBILE","FACEBOOK_PROFILE_REELS_MOBILE","FACEBOOK_REELS_BANNER","FACEBOOK_REELS_BANNER_DESKTOP","FACEBOOK_REELS_BANNER_FULLSCREEN_IOS","FACEBOOK_REELS_BANNER_FULLSCREEN_MOBILE","FACEBOOK_REELS_MOBILE","FACEBOOK_REELS_POSTLOOP","FACEBOOK_REELS_STICKER","FACEBOOK_STORY_MOBILE","FACEBOOK_STORY_STICKER_MOBILE","INSTAGRAM_EXPLORE_CONTEXTUAL","INSTAGRAM_EXPLORE_GRID_HOME","INSTAGRAM_EXPLORE_IMMERSIVE","INSTAGRAM_FEED_WEB","INSTAGRAM_FEED_WEB_M_SITE","INSTAGRAM_LEAD_GEN_MULTI_SUBMIT_ADS","INSTAGRAM_PROFILE_FEED","INSTAGRAM_PROFILE_REELS","INSTAGRAM_REELS","INSTAGRAM_REELS_OVERLAY","INSTAGRAM_SEARCH_CHAIN","INSTAGRAM_SEARCH_GRID","INSTAGRAM_STANDARD","INSTAGRAM_STORY","INSTAGRAM_STORY_EFFECT_TRAY","INSTAGRAM_STORY_WEB","INSTAGRAM_STORY_WEB_M_SITE","INSTANT_ARTICLE_RECIRCULATION_AD","INSTANT_ARTICLE_STANDARD","INSTREAM_BANNER_DESKTOP","INSTREAM_BANNER_FULLSCREEN_IOS","INSTREAM_BANNER_FULLSCREEN_MOBILE","INSTREAM_BANNER_IMMERSIVE_MOBILE","INSTREAM_BANNER_MOBILE","INSTREAM_VIDEO_DESKTOP","INSTREAM_VIDEO_FULLSCREEN_IOS","INSTREAM_VIDEO_FULLSCREEN_MOBILE","INSTREAM_VIDEO_IMAGE","INSTREAM_VIDEO_IMMERSIVE_MOBILE","INSTREAM_VIDEO_MOBILE","JOB_BROWSER_DESKTOP","JOB_BROWSER_MOBILE","MARKETPLACE_MOBILE","MESSENGER_MOBILE_INBOX_MEDIA","MESSENGER_MOBILE_STORY_MEDIA","MOBILE_BANNER","MOBILE_FEED_BASIC","MOBILE_FEED_STANDARD","MOBILE_FULLWIDTH","MOBILE_INTERSTITIAL","MOBILE_MEDIUM_RECTANGLE","MOBILE_NATIVE","RIGHT_COLUMN_STANDARD","SUGGESTED_VIDEO_DESKTOP","SUGGESTED_VIDEO_FULLSCREEN_MOBILE","SUGGESTED_VIDEO_IMMERSIVE_MOBILE","SUGGESTED_VIDEO_MOBILE","WATCH_FEED_HOME","WATCH_FEED_MOBILE"],"type":"string"},"after":{"description":"Cursor for pagination (next page)","type":"string"},"api_version":{"description":"Graph API version for this call, e.g. \"v23.0\" (defaults to FACEBOOK_API_VERSION)","pattern":"^v[0-9]+\\.[0-9]+$","type":"string"},"before":{"description":"Cursor for pagination (previous page)","type":"string"},"creative_feature":{"description":"Creative Feature (enum: adgrouppreviews_creative_feature_enum_param)","enum":["product_metadata_automation","profile_card","standard_enhancements_catalog","video_to_image"],"type":"string"},"dynamic_asset_label":{"description":"Dynamic Asset Label","type":"string"},"dynamic_creative_spec":{"additionalProperties":true,"description":"Dynamic Creative Spec","type":"object"},"dynamic_customization":{"additionalProperties":true,"description":"Dynamic Customization","type":"object"},"end_date":{"description":"End Date","type":"string"},"fetch_all":{"description":"Follow paging.next and return all pages merged into one data array (subject to max_items/max_pages and a hard safety cap)","type":"boolean"},"fields":{"description":"Fields to return","items":{"type":"string"},"type":"array"},"height":{"description":"Height","type":"integer"},"id":{"description":"Ad ID","pattern":"^[0-9]+$","type":"string"},"limit":{"description":"Maximum number of results","maximum":100,"minimum":1,"type":"integer"},"locale":{"description":"Locale","type":"string"},"max_items":{"description":"Maximum number of items to return across pages","maximum":5000,"minimum":1,"type":"integer"},"max_pages":{"description":"Maximum number of pages to fetch","maximum":50,"minimum":1,"type":"integer"},"place_page_id":{"description":"ID of the Place Page","pattern":"^[0-9]+$","type":"integer"},"post":{"additionalProperties":true,"description":"Post","type":"object"},"product_item_ids":{"description":"Product Item Ids","items":{"type":"string"},"type":"array"},"render_type":{"description":"Render Type (enum: adgrouppreviews_render_type_enum_param)","enum":["FALLBACK"],"type":"string"},"start_date":{"description":"Start Date","type":"string"},"structured":{"description":"Decode the response into typed AdPreview objects, with RFC 3339 UTC timestamps, and return it as structured content","type":"boolean"},"width":{"description":"Width","type":"integer"}},"required":["id","ad_format"],"type":"object"}`),
 	)
+	tool.RawOutputSchema = json.RawMessage(`{"additionalProperties":true,"properties":{"data":{"items":{"additionalProperties":true,"properties":{"body":{"type":"string"},"transformation_spec":{"type":"object"}},"type":"object"},"type":"array"},"pagination":{"type":"object"},"paging":{"type":"object"},"summary":{"type":"object"}},"type":"object"}`)
+	tool.Annotations = common.ReadOnlyAnnotation("Ad: List Previews")
 
 	s.AddTool(tool, AdListPreviewsHandler)
 	return nil
@@ -51,6 +85,10 @@ func RegisterAdListPreviewsHandler(s *server.MCPServer) error {
 
 // AdListPreviewsHandler handles the ad_list_previews tool
 func AdListPreviewsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if problems := common.ValidateArguments(request.GetArguments(), ad_list_previewsRules); len(problems) > 0 {
+		return common.ValidationErrorResult(problems), nil
+	}
+
 	var args ad_list_previewsArgs
 	if err := request.BindArguments(&args); err != nil {
 		return common.HandleBindError(err)
@@ -80,23 +118,31 @@ func AdListPreviewsHandler(ctx context.Context, request mcp.CallToolRequest) (*m
 	if args.DynamicAssetLabel != "" {
 		params["dynamic_asset_label"] = args.DynamicAssetLabel
 	}
-	// TODO: Handle map[string]interface{} for DynamicCreativeSpec
-	// TODO: Handle map[string]interface{} for DynamicCustomization
+	if err := common.SetJSONParam(params, "dynamic_creative_spec", args.DynamicCreativeSpec); err != nil {
+		return common.HandleBindError(err)
+	}
+	if err := common.SetJSONParam(params, "dynamic_customization", args.DynamicCustomization); err != nil {
+		return common.HandleBindError(err)
+	}
 	if args.EndDate != "" {
 		params["end_date"] = args.EndDate
 	}
-	if args.Height > 0 {
-		params["height"] = fmt.Sprintf("%d", args.Height)
+	if args.Height != nil {
+		params["height"] = fmt.Sprintf("%v", *args.Height)
 	}
 	if args.Locale != "" {
 		params["locale"] = args.Locale
 	}
-	if args.PlacePageId > 0 {
-		params["place_page_id"] = fmt.Sprintf("%d", args.PlacePageId)
+	if args.PlacePageId != nil {
+		params["place_page_id"] = fmt.Sprintf("%v", *args.PlacePageId)
+	}
+	if err := common.SetJSONParam(params, "post", args.Post); err != nil {
+		return common.HandleBindError(err)
 	}
-	// TODO: Handle map[string]interface{} for Post
 	if len(args.ProductItemIds) > 0 {
 		params["product_item_ids"] = strings.Join(args.ProductItemIds, ",")
+	} else if args.ProductItemIds != nil {
+		params["product_item_ids"] = "[]"
 	}
 	if args.RenderType != "" {
 		params["render_type"] = args.RenderType
@@ -104,19 +150,24 @@ func AdListPreviewsHandler(ctx context.Context, request mcp.CallToolRequest) (*m
 	if args.StartDate != "" {
 		params["start_date"] = args.StartDate
 	}
-	if args.Width > 0 {
-		params["width"] = fmt.Sprintf("%d", args.Width)
+	if args.Width != nil {
+		params["width"] = fmt.Sprintf("%v", *args.Width)
 	}
 
-	result, err := common.MakeGraphAPIRequest(ctx, "GET", endpoint, params, nil)
+	pagination := common.PaginationOptions{
+		FetchAll: args.FetchAll,
+		MaxItems: args.MaxItems,
+		MaxPages: args.MaxPages,
+	}
+	result, err := common.MakePaginatedGraphAPIRequest(ctx, endpoint, params, pagination)
 
 	if err != nil {
 		return common.HandleAPIError(err)
 	}
 
-	return &mcp.CallToolResult{
-		Content: []mcp.Content{
-			mcp.NewTextContent(string(result)),
-		},
-	}, nil
+	if args.Structured {
+		return common.StructuredListResult[common.AdPreview](result)
+	}
+
+	return common.GraphResult[common.AdPreview](result, true)
 }
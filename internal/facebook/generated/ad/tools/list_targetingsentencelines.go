@@ -16,11 +16,29 @@ import (
 
 // ad_list_targetingsentencelinesArgs defines the typed arguments for ad_list_targetingsentencelines
 type ad_list_targetingsentencelinesArgs struct {
-	ID     string   `json:"id" jsonschema:"required,description=Ad ID,pattern=^[0-9]+$"`
-	Fields []string `json:"fields,omitempty" jsonschema:"description=Fields to return"`
-	Limit  int      `json:"limit,omitempty" jsonschema:"description=Maximum number of results,minimum=1,maximum=100"`
-	After  string   `json:"after,omitempty" jsonschema:"description=Cursor for pagination (next page)"`
-	Before string   `json:"before,omitempty" jsonschema:"description=Cursor for pagination (previous page)"`
+	ID         string   `json:"id" jsonschema:"required,description=Ad ID,pattern=^[0-9]+$"`
+	Fields     []string `json:"fields,omitempty" jsonschema:"description=Fields to return"`
+	Limit      int      `json:"limit,omitempty" jsonschema:"description=Maximum number of results,minimum=1,maximum=100"`
+	After      string   `json:"after,omitempty" jsonschema:"description=Cursor for pagination (next page)"`
+	Before     string   `json:"before,omitempty" jsonschema:"description=Cursor for pagination (previous page)"`
+	FetchAll   bool     `json:"fetch_all,omitempty" jsonschema:"description=Follow paging.next and merge all pages"`
+	MaxItems   int      `json:"max_items,omitempty" jsonschema:"description=Maximum number of items to return across pages,minimum=1,maximum=5000"`
+	MaxPages   int      `json:"max_pages,omitempty" jsonschema:"description=Maximum number of pages to fetch,minimum=1,maximum=50"`
+	Structured bool     `json:"structured,omitempty" jsonschema:"description=Decode the response into typed TargetingSentenceLine objects and return structured content"`
+}
+
+// ad_list_targetingsentencelinesRules are checked against the raw arguments before calling the Graph API
+var ad_list_targetingsentencelinesRules = []common.ParamRule{
+	{Name: "after", Type: "string"},
+	{Name: "api_version", Type: "string", Pattern: "^v[0-9]+\\.[0-9]+$"},
+	{Name: "before", Type: "string"},
+	{Name: "fetch_all", Type: "boolean"},
+	{Name: "fields", Type: "array"},
+	{Name: "id", Type: "string", Required: true, Pattern: "^[0-9]+$"},
+	{Name: "limit", Type: "integer", Minimum: common.Bound(1), Maximum: common.Bound(100)},
+	{Name: "max_items", Type: "integer", Minimum: common.Bound(1), Maximum: common.Bound(5000)},
+	{Name: "max_pages", Type: "integer", Minimum: common.Bound(1), Maximum: common.Bound(50)},
+	{Name: "structured", Type: "boolean"},
 }
 
 // RegisterAdListTargetingsentencelinesHandler registers the ad_list_targetingsentencelines tool
@@ -28,8 +46,10 @@ func RegisterAdListTargetingsentencelinesHandler(s *server.MCPServer) error {
 	tool := mcp.NewToolWithRawSchema(
 		"ad_list_targetingsentencelines",
 		"List targetingsentencelines for this Ad Returns TargetingSentenceLine.",
-		json.RawMessage(`{"additionalProperties":false,"properties":{"after":{"description":"Cursor for pagination (next page)","type":"string"},"before":{"description":"Cursor for pagination (previous page)","type":"string"},"fields":{"description":"Fields to return","items":{"type":"string"},"type":"array"},"id":{"description":"Ad ID","pattern":"^[0-9]+$","type":"string"},"limit":{"description":"Maximum number of results","maximum":100,"minimum":1,"type":"integer"}},"required":["id"],"type":"object"}`),
+		json.RawMessage(`{"additionalProperties":false,"properties":{"after":{"description":"Cursor for pagination (next page)","type":"string"},"api_version":{"description":"Graph API version for this call, e.g. \"v23.0\" (defaults to FACEBOOK_API_VERSION)","pattern":"^v[0-9]+\\.[0-9]+$","type":"string"},"before":{"description":"Cursor for pagination (previous page)","type":"string"},"fetch_all":{"description":"Follow paging.next and return all pages merged into one data array (subject to max_items/max_pages and a hard safety cap)","type":"boolean"},"fields":{"description":"Fields to return","items":{"type":"string"},"type":"array"},"id":{"description":"Ad ID","pattern":"^[0-9]+$","type":"string"},"limit":{"description":"Maximum number of results","maximum":100,"minimum":1,"type":"integer"},"max_items":{"description":"Maximum number of items to return across pages","maximum":5000,"minimum":1,"type":"integer"},"max_pages":{"description":"Maximum number of pages to fetch","maximum":50,"minimum":1,"type":"integer"},"structured":{"description":"Decode the response into typed TargetingSentenceLine objects, with RFC 3339 UTC timestamps, and return it as structured content","type":"boolean"}},"required":["id"],"type":"object"}`),
 	)
+	tool.RawOutputSchema = json.RawMessage(`{"additionalProperties":true,"properties":{"data":{"items":{"additionalProperties":true,"properties":{"id":{"type":"string"},"params":{"type":"object"},"targetingsentencelines":{}},"type":"object"},"type":"array"},"pagination":{"type":"object"},"paging":{"type":"object"},"summary":{"type":"object"}},"type":"object"}`)
+	tool.Annotations = common.ReadOnlyAnnotation("Ad: List Targetingsentencelines")
 
 	s.AddTool(tool, AdListTargetingsentencelinesHandler)
 	return nil
@@ -37,6 +57,10 @@ func RegisterAdListTargetingsentencelinesHandler(s *server.MCPServer) error {
 
 // AdListTargetingsentencelinesHandler handles the ad_list_targetingsentencelines tool
 func AdListTargetingsentencelinesHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if problems := common.ValidateArguments(request.GetArguments(), ad_list_targetingsentencelinesRules); len(problems) > 0 {
+		return common.ValidationErrorResult(problems), nil
+	}
+
 	var args ad_list_targetingsentencelinesArgs
 	if err := request.BindArguments(&args); err != nil {
 		return common.HandleBindError(err)
@@ -58,15 +82,20 @@ func AdListTargetingsentencelinesHandler(ctx context.Context, request mcp.CallTo
 		params["before"] = args.Before
 	}
 
-	result, err := common.MakeGraphAPIRequest(ctx, "GET", endpoint, params, nil)
+	pagination := common.PaginationOptions{
+		FetchAll: args.FetchAll,
+		MaxItems: args.MaxItems,
+		MaxPages: args.MaxPages,
+	}
+	result, err := common.MakePaginatedGraphAPIRequest(ctx, endpoint, params, pagination)
 
 	if err != nil {
 		return common.HandleAPIError(err)
 	}
 
-	return &mcp.CallToolResult{
-		Content: []mcp.Content{
-			mcp.NewTextContent(string(result)),
-		},
-	}, nil
+	if args.Structured {
+		return common.StructuredListResult[common.TargetingSentenceLine](result)
+	}
+
+	return common.GraphResult[common.TargetingSentenceLine](result, true)
 }
@@ -20,13 +20,26 @@ type ad_account_create_account_controlArgs struct {
 	PlacementControls map[string]interface{} `json:"placement_controls,omitempty" jsonschema:"description=Placement Controls"`
 }
 
+// ad_account_create_account_controlRules are checked against the raw arguments before calling the Graph API
+var ad_account_create_account_controlRules = []common.ParamRule{
+	{Name: "api_version", Type: "string", Pattern: "^v[0-9]+\\.[0-9]+$"},
+	{Name: "audience_controls", Type: "object", Required: true},
+	{Name: "confirmation_token", Type: "string"},
+	{Name: "dry_run", Type: "boolean"},
+	{Name: "id", Type: "string", Required: true, Pattern: "^[0-9]+$"},
+	{Name: "idempotency_key", Type: "string"},
+	{Name: "placement_controls", Type: "object"},
+}
+
 // RegisterAdAccountCreateAccountControlHandler registers the ad_account_create_account_control tool
 func RegisterAdAccountCreateAccountControlHandler(s *server.MCPServer) error {
 	tool := mcp.NewToolWithRawSchema(
 		"ad_account_create_account_control",
 		"Create or update account_controls for this AdAccount Returns AdAccountBusinessConstraints. Required: audience_controls",
-		json.RawMessage(`{"additionalProperties":false,"properties":{"audience_controls":{"additionalProperties":true,"description":"Audience Controls","type":"object"},"id":{"description":"AdAccount ID","pattern":"^[0-9]+$","type":"string"},"placement_controls":{"additionalProperties":true,"description":"Placement Controls","type":"object"}},"required":["id","audience_controls"],"type":"object"}`),
+		json.RawMessage(`{"additionalProperties":false,"properties":{"api_version":{"description":"Graph API version for this call, e.g. \"v23.0\" (defaults to FACEBOOK_API_VERSION)","pattern":"^v[0-9]+\\.[0-9]+$","type":"string"},"audience_controls":{"additionalProperties":true,"description":"Audience Controls","type":"object"},"confirmation_token":{"description":"Token returned when the server asked for this change to be confirmed. Repeat the call with the same arguments and the token to apply it.","type":"string"},"dry_run":{"description":"Do not apply the change. Where supported the Graph API validates it with execution_options=[\"validate_only\"]; otherwise the request that would be sent is returned.","type":"boolean"},"id":{"description":"AdAccount ID","pattern":"^[0-9]+$","type":"string"},"idempotency_key":{"description":"Unique key for this create, e.g. a UUID. Retrying with the same key and arguments returns the original result instead of creating another object.","maxLength":255,"minLength":1,"type":"string"},"placement_controls":{"additionalProperties":true,"description":"Placement Controls","type":"object"}},"required":["id","audience_controls"],"type":"object"}`),
 	)
+	tool.RawOutputSchema = json.RawMessage(`{"additionalProperties":true,"properties":{"audience_controls":{"type":"object"},"campaigns_with_error":{"items":{"type":"string"},"type":"array"},"placement_controls":{"type":"object"},"status":{"type":"string"}},"type":"object"}`)
+	tool.Annotations = common.CreateAnnotation("Ad Account: Create Account Control")
 
 	s.AddTool(tool, AdAccountCreateAccountControlHandler)
 	return nil
@@ -34,6 +47,10 @@ func RegisterAdAccountCreateAccountControlHandler(s *server.MCPServer) error {
 
 // AdAccountCreateAccountControlHandler handles the ad_account_create_account_control tool
 func AdAccountCreateAccountControlHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if problems := common.ValidateArguments(request.GetArguments(), ad_account_create_account_controlRules); len(problems) > 0 {
+		return common.ValidationErrorResult(problems), nil
+	}
+
 	var args ad_account_create_account_controlArgs
 	if err := request.BindArguments(&args); err != nil {
 		return common.HandleBindError(err)
@@ -41,8 +58,16 @@ func AdAccountCreateAccountControlHandler(ctx context.Context, request mcp.CallT
 	endpoint := fmt.Sprintf("/%s/account_controls", args.ID)
 	// Prepare request body
 	body := make(map[string]interface{})
-	body["audience_controls"] = args.AudienceControls
-	body["placement_controls"] = args.PlacementControls
+	if args.AudienceControls != nil {
+		body["audience_controls"] = args.AudienceControls
+	}
+	if args.PlacementControls != nil {
+		body["placement_controls"] = args.PlacementControls
+	}
+
+	if common.IsDryRun(request) {
+		return common.DryRunResult(ctx, "POST", endpoint, nil, body)
+	}
 
 	result, err := common.MakeGraphAPIRequest(ctx, "POST", endpoint, nil, body)
 
@@ -50,9 +75,5 @@ func AdAccountCreateAccountControlHandler(ctx context.Context, request mcp.CallT
 		return common.HandleAPIError(err)
 	}
 
-	return &mcp.CallToolResult{
-		Content: []mcp.Content{
-			mcp.NewTextContent(string(result)),
-		},
-	}, nil
+	return common.GraphResult[common.AdAccountBusinessConstraints](result, false)
 }